@@ -0,0 +1,191 @@
+package analysis
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"signal-analysis/models"
+)
+
+const anthropicAPIVersion = "2023-06-01"
+
+// anthropicProvider implements Provider against the Anthropic Messages API,
+// whose request/response shape differs enough from the OpenAI-compatible
+// providers (x-api-key header, anthropic-version header, top-level
+// content[] blocks) to warrant its own implementation rather than bending
+// openAICompatProvider to fit.
+type anthropicProvider struct {
+	apiKey  string
+	model   string
+	baseURL string
+	client  *http.Client
+}
+
+// NewAnthropic builds the Anthropic provider from ANTHROPIC_API_KEY and
+// optionally ANTHROPIC_MODEL (default claude-3-5-sonnet-latest).
+func NewAnthropic() (Provider, error) {
+	apiKey := os.Getenv("ANTHROPIC_API_KEY")
+	if apiKey == "" {
+		return nil, errMissingAPIKey("anthropic", "ANTHROPIC_API_KEY")
+	}
+	model := os.Getenv("ANTHROPIC_MODEL")
+	if model == "" {
+		model = "claude-3-5-sonnet-latest"
+	}
+	return &anthropicProvider{
+		apiKey:  apiKey,
+		model:   model,
+		baseURL: "https://api.anthropic.com/v1/messages",
+		client:  &http.Client{Timeout: 60 * time.Second},
+	}, nil
+}
+
+func (p *anthropicProvider) Name() string { return "anthropic" }
+
+type anthropicRequest struct {
+	Model     string             `json:"model"`
+	MaxTokens int                `json:"max_tokens"`
+	Messages  []anthropicMessage `json:"messages"`
+	Stream    bool               `json:"stream"`
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicResponse struct {
+	Content []struct {
+		Text string `json:"text"`
+	} `json:"content"`
+}
+
+// anthropicStreamEvent covers the "content_block_delta" SSE events; other
+// event types (message_start, message_stop, ...) are ignored.
+type anthropicStreamEvent struct {
+	Type  string `json:"type"`
+	Delta struct {
+		Text string `json:"text"`
+	} `json:"delta"`
+}
+
+func (p *anthropicProvider) setHeaders(req *http.Request) {
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", p.apiKey)
+	req.Header.Set("anthropic-version", anthropicAPIVersion)
+}
+
+func (p *anthropicProvider) GenerateAnalysis(ctx context.Context, signal models.Signal, lang, style string) (string, error) {
+	prompt, err := renderPrompt(promptName(lang, style), signal)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(anthropicRequest{
+		Model:     p.model,
+		MaxTokens: 1024,
+		Messages:  []anthropicMessage{{Role: "user", Content: prompt}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	p.setHeaders(req)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", newHTTPError(p.Name(), resp)
+	}
+
+	var parsed anthropicResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", err
+	}
+	if len(parsed.Content) == 0 {
+		return "", fmt.Errorf("anthropic: empty response")
+	}
+	return parsed.Content[0].Text, nil
+}
+
+func (p *anthropicProvider) StreamAnalysis(ctx context.Context, signal models.Signal, lang, style string) (<-chan Chunk, error) {
+	prompt, err := renderPrompt(promptName(lang, style), signal)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(anthropicRequest{
+		Model:     p.model,
+		MaxTokens: 1024,
+		Messages:  []anthropicMessage{{Role: "user", Content: prompt}},
+		Stream:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	p.setHeaders(req)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, newHTTPError(p.Name(), resp)
+	}
+
+	out := make(chan Chunk)
+	go func() {
+		defer close(out)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+
+			var event anthropicStreamEvent
+			if err := json.Unmarshal([]byte(data), &event); err != nil {
+				continue
+			}
+			if event.Type != "content_block_delta" || event.Delta.Text == "" {
+				continue
+			}
+			select {
+			case out <- Chunk{Content: event.Delta.Text}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			select {
+			case out <- Chunk{Err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+	return out, nil
+}
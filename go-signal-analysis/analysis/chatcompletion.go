@@ -0,0 +1,189 @@
+package analysis
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"signal-analysis/models"
+)
+
+// chatMessage mirrors the OpenAI-compatible chat completion message shape,
+// shared by DeepSeek, OpenAI-compatible endpoints and Ollama.
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// chatStreamChunk is one SSE `data: {...}` frame from an OpenAI-compatible
+// streaming response.
+type chatStreamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+}
+
+// openAICompatProvider implements Provider against any OpenAI-compatible
+// chat completions endpoint: DeepSeek, OpenAI itself, and Ollama's
+// /v1/chat/completions shim all speak this protocol.
+type openAICompatProvider struct {
+	name       string
+	baseURL    string
+	model      string
+	apiKey     string
+	promptLang string // not read: each call picks its template via promptName(lang, style)
+	client     *http.Client
+}
+
+func newOpenAICompatProvider(name, baseURL, model, apiKey string) *openAICompatProvider {
+	return &openAICompatProvider{
+		name:    name,
+		baseURL: baseURL,
+		model:   model,
+		apiKey:  apiKey,
+		client:  &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+func (p *openAICompatProvider) Name() string { return p.name }
+
+func (p *openAICompatProvider) GenerateAnalysis(ctx context.Context, signal models.Signal, lang, style string) (string, error) {
+	prompt, err := renderPrompt(promptName(lang, style), signal)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(chatRequest{
+		Model:    p.model,
+		Messages: []chatMessage{{Role: "user", Content: prompt}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	p.setHeaders(req)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", newHTTPError(p.name, resp)
+	}
+
+	var parsed chatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", err
+	}
+	if len(parsed.Choices) == 0 {
+		return "", fmt.Errorf("%s: empty response", p.name)
+	}
+	return parsed.Choices[0].Message.Content, nil
+}
+
+func (p *openAICompatProvider) StreamAnalysis(ctx context.Context, signal models.Signal, lang, style string) (<-chan Chunk, error) {
+	prompt, err := renderPrompt(promptName(lang, style), signal)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(chatRequest{
+		Model:    p.model,
+		Messages: []chatMessage{{Role: "user", Content: prompt}},
+		Stream:   true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	p.setHeaders(req)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, newHTTPError(p.name, resp)
+	}
+
+	out := make(chan Chunk)
+	go func() {
+		defer close(out)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if data == "[DONE]" {
+				return
+			}
+
+			var chunk chatStreamChunk
+			if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+				continue
+			}
+			if len(chunk.Choices) == 0 {
+				continue
+			}
+			if content := chunk.Choices[0].Delta.Content; content != "" {
+				select {
+				case out <- Chunk{Content: content}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			select {
+			case out <- Chunk{Err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (p *openAICompatProvider) setHeaders(req *http.Request) {
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+}
+
+func newHTTPError(provider string, resp *http.Response) error {
+	return &httpStatusError{provider: provider, statusCode: resp.StatusCode}
+}
@@ -0,0 +1,12 @@
+package analysis
+
+import "os"
+
+// NewDeepSeek builds the DeepSeek provider from DEEPSEEK_API_KEY.
+func NewDeepSeek() (Provider, error) {
+	apiKey := os.Getenv("DEEPSEEK_API_KEY")
+	if apiKey == "" {
+		return nil, errMissingAPIKey("deepseek", "DEEPSEEK_API_KEY")
+	}
+	return newOpenAICompatProvider("deepseek", "https://api.deepseek.com/v1", "deepseek-chat", apiKey), nil
+}
@@ -0,0 +1,64 @@
+package analysis
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+func errMissingAPIKey(provider, envVar string) error {
+	return fmt.Errorf("%s: %s not configured", provider, envVar)
+}
+
+// providerBuilders maps an LLM_PROVIDER/LLM_PROVIDERS name to its builder.
+var providerBuilders = map[string]func() (Provider, error){
+	"deepseek":  NewDeepSeek,
+	"openai":    NewOpenAI,
+	"anthropic": NewAnthropic,
+	"ollama":    NewOllama,
+}
+
+// NewProviderFromEnv builds the configured Provider. LLM_PROVIDERS (comma
+// separated, e.g. "deepseek,openai") builds a MultiProvider that tries each
+// in order; LLM_PROVIDER (single name, default "deepseek") builds just one.
+// Providers that fail to construct (missing API key) are skipped with a
+// logged reason rather than aborting startup.
+func NewProviderFromEnv() (Provider, error) {
+	names := strings.Split(os.Getenv("LLM_PROVIDERS"), ",")
+	if os.Getenv("LLM_PROVIDERS") == "" {
+		single := os.Getenv("LLM_PROVIDER")
+		if single == "" {
+			single = "deepseek"
+		}
+		names = []string{single}
+	}
+
+	var providers []Provider
+	var buildErrs []error
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		builder, ok := providerBuilders[name]
+		if !ok {
+			err := fmt.Errorf("unknown LLM provider %q", name)
+			log.Printf("skipping LLM provider: %v", err)
+			buildErrs = append(buildErrs, err)
+			continue
+		}
+		p, err := builder()
+		if err != nil {
+			log.Printf("skipping LLM provider: %v", err)
+			buildErrs = append(buildErrs, err)
+			continue
+		}
+		providers = append(providers, p)
+	}
+
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no usable LLM providers: %v", buildErrs)
+	}
+	if len(providers) == 1 {
+		return providers[0], nil
+	}
+	return NewMultiProvider(providers...), nil
+}
@@ -0,0 +1,171 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"signal-analysis/models"
+)
+
+const (
+	circuitFailureThreshold = 3
+	circuitOpenDuration     = 30 * time.Second
+	maxBackoffAttempts      = 3
+	baseBackoff             = 500 * time.Millisecond
+	maxBackoff              = 8 * time.Second
+)
+
+// circuitState tracks a single provider's health so MultiProvider can skip
+// it for a cooldown period after repeated failures instead of retrying a
+// provider that is clearly down.
+type circuitState struct {
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+func (s *circuitState) open() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return time.Now().Before(s.openUntil)
+}
+
+func (s *circuitState) recordSuccess() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = 0
+	s.openUntil = time.Time{}
+}
+
+func (s *circuitState) recordFailure() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures++
+	if s.failures >= circuitFailureThreshold {
+		s.openUntil = time.Now().Add(circuitOpenDuration)
+	}
+}
+
+// MultiProvider tries providers in order, skipping any whose circuit breaker
+// is open, and retries a retriable (429/5xx) failure with jittered
+// exponential backoff before moving to the next provider.
+type MultiProvider struct {
+	providers []Provider
+	circuits  map[string]*circuitState
+}
+
+// NewMultiProvider wraps providers, trying each in order on failure.
+func NewMultiProvider(providers ...Provider) *MultiProvider {
+	circuits := make(map[string]*circuitState, len(providers))
+	for _, p := range providers {
+		circuits[p.Name()] = &circuitState{}
+	}
+	return &MultiProvider{providers: providers, circuits: circuits}
+}
+
+func (m *MultiProvider) Name() string { return "multi" }
+
+func (m *MultiProvider) GenerateAnalysis(ctx context.Context, signal models.Signal, lang, style string) (string, error) {
+	var lastErr error
+	for _, p := range m.providers {
+		circuit := m.circuits[p.Name()]
+		if circuit.open() {
+			continue
+		}
+
+		result, err := m.generateWithBackoff(ctx, p, signal, lang, style)
+		if err == nil {
+			circuit.recordSuccess()
+			return result, nil
+		}
+		circuit.recordFailure()
+		lastErr = fmt.Errorf("%s: %w", p.Name(), err)
+	}
+	if lastErr == nil {
+		return "", fmt.Errorf("no LLM providers configured")
+	}
+	return "", fmt.Errorf("all providers failed, last error: %w", lastErr)
+}
+
+func (m *MultiProvider) generateWithBackoff(ctx context.Context, p Provider, signal models.Signal, lang, style string) (string, error) {
+	var err error
+	for attempt := 0; attempt < maxBackoffAttempts; attempt++ {
+		var result string
+		result, err = p.GenerateAnalysis(ctx, signal, lang, style)
+		if err == nil {
+			return result, nil
+		}
+		if !isRetriable(err) {
+			return "", err
+		}
+		if attempt == maxBackoffAttempts-1 {
+			// Last attempt: no further retry will use the wait, so fail
+			// over to the next provider immediately instead of sleeping.
+			break
+		}
+
+		select {
+		case <-time.After(jitteredBackoff(attempt)):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+	return "", err
+}
+
+// StreamAnalysis streams from the first provider whose circuit is closed;
+// streaming responses aren't retried mid-stream, only at provider selection.
+func (m *MultiProvider) StreamAnalysis(ctx context.Context, signal models.Signal, lang, style string) (<-chan Chunk, error) {
+	var lastErr error
+	for _, p := range m.providers {
+		circuit := m.circuits[p.Name()]
+		if circuit.open() {
+			continue
+		}
+		ch, err := p.StreamAnalysis(ctx, signal, lang, style)
+		if err == nil {
+			circuit.recordSuccess()
+			return ch, nil
+		}
+		circuit.recordFailure()
+		lastErr = fmt.Errorf("%s: %w", p.Name(), err)
+	}
+	if lastErr == nil {
+		return nil, fmt.Errorf("no LLM providers configured")
+	}
+	return nil, fmt.Errorf("all providers failed, last error: %w", lastErr)
+}
+
+// jitteredBackoff returns a delay drawn uniformly from [d/2, d), where d is
+// the exponential delay for attempt capped at maxBackoff.
+func jitteredBackoff(attempt int) time.Duration {
+	d := baseBackoff * time.Duration(1<<attempt)
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	jitter := time.Duration(rand.Int63n(int64(d) / 2))
+	return d/2 + jitter
+}
+
+// httpStatusError lets providers report the status code so isRetriable can
+// distinguish a rate limit/outage from a permanent failure.
+type httpStatusError struct {
+	provider   string
+	statusCode int
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("%s: unexpected status %d", e.provider, e.statusCode)
+}
+
+func isRetriable(err error) bool {
+	statusErr, ok := err.(*httpStatusError)
+	if !ok {
+		return false
+	}
+	return statusErr.statusCode == http.StatusTooManyRequests || statusErr.statusCode >= 500
+}
@@ -0,0 +1,19 @@
+package analysis
+
+import "os"
+
+// NewOllama builds a provider for a local Ollama instance, configured via
+// OLLAMA_BASE_URL (default http://localhost:11434/v1) and OLLAMA_MODEL
+// (default llama3). Ollama's OpenAI-compatible shim needs no API key.
+func NewOllama() (Provider, error) {
+	baseURL := os.Getenv("OLLAMA_BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:11434/v1"
+	}
+	model := os.Getenv("OLLAMA_MODEL")
+	if model == "" {
+		model = "llama3"
+	}
+
+	return newOpenAICompatProvider("ollama", baseURL, model, ""), nil
+}
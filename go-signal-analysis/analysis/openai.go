@@ -0,0 +1,24 @@
+package analysis
+
+import "os"
+
+// NewOpenAI builds a provider for OpenAI or any OpenAI-compatible endpoint,
+// configured via OPENAI_API_KEY, OPENAI_BASE_URL (default
+// https://api.openai.com/v1) and OPENAI_MODEL (default gpt-4o-mini).
+func NewOpenAI() (Provider, error) {
+	apiKey := os.Getenv("OPENAI_API_KEY")
+	if apiKey == "" {
+		return nil, errMissingAPIKey("openai", "OPENAI_API_KEY")
+	}
+
+	baseURL := os.Getenv("OPENAI_BASE_URL")
+	if baseURL == "" {
+		baseURL = "https://api.openai.com/v1"
+	}
+	model := os.Getenv("OPENAI_MODEL")
+	if model == "" {
+		model = "gpt-4o-mini"
+	}
+
+	return newOpenAICompatProvider("openai", baseURL, model, apiKey), nil
+}
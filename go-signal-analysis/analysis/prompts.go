@@ -0,0 +1,115 @@
+package analysis
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+
+	"signal-analysis/models"
+)
+
+// PromptsDir is where *.tmpl prompt templates live, relative to the working
+// directory the server is started from.
+const PromptsDir = "prompts"
+
+// promptRegistry holds parsed prompt templates keyed by name (the file's
+// base name without extension, e.g. "english", "russian", "risk-focused").
+// Templates are data, not code, so adding a new language or analyst style is
+// a matter of dropping in a new .tmpl file, no recompile required.
+type promptRegistry struct {
+	mu        sync.RWMutex
+	templates map[string]*template.Template
+}
+
+var registry = &promptRegistry{templates: map[string]*template.Template{}}
+
+// LoadPrompts parses every prompts/*.tmpl file into the registry. Call once
+// at startup; safe to call again to pick up new/edited templates.
+func LoadPrompts(dir string) error {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return err
+	}
+	if len(matches) == 0 {
+		return fmt.Errorf("no prompt templates found in %s", dir)
+	}
+
+	parsed := make(map[string]*template.Template, len(matches))
+	for _, path := range matches {
+		body, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read prompt %s: %w", path, err)
+		}
+		name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		tmpl, err := template.New(name).Parse(string(body))
+		if err != nil {
+			return fmt.Errorf("parse prompt %s: %w", path, err)
+		}
+		parsed[name] = tmpl
+	}
+
+	registry.mu.Lock()
+	registry.templates = parsed
+	registry.mu.Unlock()
+	return nil
+}
+
+// promptData is what templates render against.
+type promptData struct {
+	Title      string
+	Summary    string
+	Sector     string
+	Region     string
+	Impact     int
+	Confidence int
+}
+
+// renderPrompt renders the named template ("english", "russian",
+// "risk-focused", ...) for the given signal.
+func renderPrompt(name string, signal models.Signal) (string, error) {
+	registry.mu.RLock()
+	tmpl, ok := registry.templates[name]
+	registry.mu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("unknown prompt template %q", name)
+	}
+
+	data := promptData{
+		Title:      signal.Title,
+		Summary:    signal.Summary,
+		Sector:     signal.Sector,
+		Region:     signal.Region,
+		Impact:     signal.Impact,
+		Confidence: signal.Confidence,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// promptNameForLang maps the dashboard's `lang` query param to a template
+// name. Defaults to "english" for anything other than "ru".
+func promptNameForLang(lang string) string {
+	if lang == "ru" {
+		return "russian"
+	}
+	return "english"
+}
+
+// promptName resolves the template to render for a GenerateAnalysis/
+// StreamAnalysis call: style ("risk-focused", ...) wins outright
+// when set, since analyst styles are independent of language; otherwise it
+// falls back to the language-based template.
+func promptName(lang, style string) string {
+	if style != "" {
+		return style
+	}
+	return promptNameForLang(lang)
+}
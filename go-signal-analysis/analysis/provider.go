@@ -0,0 +1,27 @@
+// Package analysis abstracts over LLM backends used to generate investment
+// analysis for a models.Signal, so handlers no longer call DeepSeek directly.
+package analysis
+
+import (
+	"context"
+
+	"signal-analysis/models"
+)
+
+// Chunk is a single piece of a streamed analysis. Err is set (and Content
+// empty) if the stream failed mid-way; the channel is closed either way.
+type Chunk struct {
+	Content string
+	Err     error
+}
+
+// Provider generates investment analysis for a signal, either all at once or
+// token-by-token. style selects an analyst style template ("risk-focused",
+// ...) and overrides the language-based template pick when
+// non-empty; pass "" to get the default per-language template.
+type Provider interface {
+	// Name identifies the provider for logging and circuit-breaker bookkeeping.
+	Name() string
+	GenerateAnalysis(ctx context.Context, signal models.Signal, lang, style string) (string, error)
+	StreamAnalysis(ctx context.Context, signal models.Signal, lang, style string) (<-chan Chunk, error)
+}
@@ -0,0 +1,56 @@
+// Command reindex streams every signal from the configured database into
+// Elasticsearch via the search package's bulk reindex path.
+//
+// Usage: go run ./cmd/reindex
+package main
+
+import (
+	"context"
+	"log"
+
+	"signal-analysis/database"
+	"signal-analysis/models"
+	"signal-analysis/search"
+)
+
+const batchSize = 500
+
+func main() {
+	database.InitDB()
+	search.Init()
+	defer search.Close()
+
+	if !search.Enabled() {
+		log.Fatal("SEARCH_ES_URL not set or Elasticsearch unreachable, nothing to reindex")
+	}
+
+	rows := make(chan models.Signal)
+	go streamSignals(rows)
+
+	count, err := search.Reindex(context.Background(), rows)
+	if err != nil {
+		log.Fatalf("reindex failed after %d docs: %v", count, err)
+	}
+	log.Printf("reindexed %d signals", count)
+}
+
+func streamSignals(out chan<- models.Signal) {
+	defer close(out)
+
+	db := database.GetDB()
+	var offset int
+	for {
+		var batch []models.Signal
+		if err := db.Order("ts_published").Offset(offset).Limit(batchSize).Find(&batch).Error; err != nil {
+			log.Printf("failed to read batch at offset %d: %v", offset, err)
+			return
+		}
+		if len(batch) == 0 {
+			return
+		}
+		for _, signal := range batch {
+			out <- signal
+		}
+		offset += len(batch)
+	}
+}
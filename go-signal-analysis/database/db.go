@@ -2,29 +2,71 @@ package database
 
 import (
 	"log"
+	"os"
+
+	"signal-analysis/models"
 
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
-var DB *gorm.DB
+var (
+	DB    *gorm.DB
+	store Store
+)
 
+// InitDB opens the configured database driver (DB_DRIVER=postgres uses
+// DATABASE_URL, anything else - including unset - uses the existing SQLite
+// file) and builds the Store handlers depend on.
 func InitDB() {
+	driver := os.Getenv("DB_DRIVER")
+
+	if driver == "postgres" {
+		dsn := os.Getenv("DATABASE_URL")
+		if dsn == "" {
+			log.Fatal("DB_DRIVER=postgres requires DATABASE_URL to be set")
+		}
+		db, s, err := NewPostgresStore(dsn)
+		if err != nil {
+			log.Fatal("Failed to connect to Postgres:", err)
+		}
+		DB = db
+		store = s
+		log.Println("Database connected successfully (postgres)")
+		return
+	}
+
 	var err error
 	DB, err = gorm.Open(sqlite.Open("../signals.db"), &gorm.Config{})
 	if err != nil {
 		log.Fatal("Failed to connect to database:", err)
 	}
 
-	// Skip auto migration - using existing database
+	// Skip auto migration for the existing tables - using existing database
 	// err = DB.AutoMigrate(&models.Signal{}, &models.Curation{})
 	// if err != nil {
 	// 	log.Fatal("Failed to migrate database:", err)
 	// }
 
-	log.Println("Database connected successfully")
+	// SavedSearch is new, so the existing database never had the table
+	// created for it - migrate just that one.
+	if err := DB.AutoMigrate(&models.SavedSearch{}); err != nil {
+		log.Fatal("Failed to migrate database:", err)
+	}
+
+	store = NewSQLiteStore(DB)
+	log.Println("Database connected successfully (sqlite)")
 }
 
+// GetDB returns the raw *gorm.DB for the handful of callers (the search
+// fallback, the reindex CLI, the cron workers) that need to stream or query
+// outside the Store contract. Set under both drivers - SQLite and Postgres
+// alike assign it during InitDB.
 func GetDB() *gorm.DB {
 	return DB
 }
+
+// GetStore returns the process-wide Store built by InitDB.
+func GetStore() Store {
+	return store
+}
@@ -0,0 +1,187 @@
+package database
+
+import (
+	"errors"
+
+	"signal-analysis/models"
+
+	"gorm.io/gorm"
+)
+
+// ErrSignalNotFound is returned by SignalByID when no row matches.
+var ErrSignalNotFound = errors.New("signal not found")
+
+// gormStore implements Store over a *gorm.DB. The query logic itself is
+// dialect-agnostic, so this same implementation backs both the SQLite store
+// (NewSQLiteStore) and the Postgres store (NewPostgresStore) - only how the
+// connection is opened and indexed differs between the two.
+type gormStore struct {
+	db *gorm.DB
+}
+
+// NewSQLiteStore wraps an already opened GORM SQLite connection (InitDB
+// opens "../signals.db") as a Store.
+func NewSQLiteStore(db *gorm.DB) Store {
+	return &gormStore{db: db}
+}
+
+func (s *gormStore) SignalByID(id string) (*models.Signal, error) {
+	var signal models.Signal
+	err := s.db.Where("id = ?", id).First(&signal).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrSignalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &signal, nil
+}
+
+func (s *gormStore) ListSignals(filter FilterParams, limit int) ([]models.Signal, error) {
+	query := s.withFilters(s.db.Model(&models.Signal{}).Select("signals.*"), filter)
+
+	var signals []models.Signal
+	err := query.Order("ts_published DESC").Limit(limit).Find(&signals).Error
+	return signals, err
+}
+
+func (s *gormStore) SaveAnalysis(signal *models.Signal) error {
+	return s.db.Save(signal).Error
+}
+
+// statsRow mirrors the column aliases of the grouped aggregate query below.
+type statsRow struct {
+	Total         int64
+	HighImpact    int64
+	MediumImpact  int64
+	AvgConfidence float64
+	Bullish       int64
+	Bearish       int64
+	Sectors       int64
+}
+
+func (s *gormStore) AggregateStats(filter FilterParams) (*Stats, error) {
+	query := s.withFilters(s.db.Model(&models.Signal{}), filter)
+
+	var row statsRow
+	err := query.Select(`
+		COUNT(*) AS total,
+		SUM(CASE WHEN impact >= 70 THEN 1 ELSE 0 END) AS high_impact,
+		SUM(CASE WHEN impact >= 50 AND impact < 70 THEN 1 ELSE 0 END) AS medium_impact,
+		AVG(confidence) AS avg_confidence,
+		SUM(CASE WHEN sentiment > 0 THEN 1 ELSE 0 END) AS bullish,
+		SUM(CASE WHEN sentiment < 0 THEN 1 ELSE 0 END) AS bearish,
+		COUNT(DISTINCT sector) AS sectors
+	`).Scan(&row).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &Stats{
+		Total:         row.Total,
+		HighImpact:    row.HighImpact,
+		MediumImpact:  row.MediumImpact,
+		AvgConfidence: row.AvgConfidence,
+		Bullish:       row.Bullish,
+		Bearish:       row.Bearish,
+		Sectors:       row.Sectors,
+	}, nil
+}
+
+func (s *gormStore) UpsertCuration(curation *models.Curation) error {
+	return s.db.Where("signal_id = ?", curation.SignalID).
+		Assign(curation).
+		FirstOrCreate(&models.Curation{}).Error
+}
+
+func (s *gormStore) CurationBySignalID(signalID string) (*models.Curation, error) {
+	var curation models.Curation
+	err := s.db.Where("signal_id = ?", signalID).First(&curation).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrCurationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &curation, nil
+}
+
+func (s *gormStore) DeleteCuration(signalID string) error {
+	return s.db.Where("signal_id = ?", signalID).Delete(&models.Curation{}).Error
+}
+
+// ListCurations returns signals LEFT JOINed with their curation row, so
+// callers see every matching signal even if it has never been curated.
+func (s *gormStore) ListCurations(filter FilterParams) ([]CuratedSignal, error) {
+	query := s.db.Table("signals").
+		Select("signals.*, curations.starred AS starred, curations.note AS note, curations.tags AS tags").
+		Joins("LEFT JOIN curations ON curations.signal_id = signals.id").
+		Where("signals.is_test = ?", false)
+
+	if filter.Starred {
+		query = query.Where("curations.starred = ?", true)
+	}
+	if filter.Tag != "" {
+		query = query.Where("curations.tags LIKE ?", "%"+filter.Tag+"%")
+	}
+
+	var rows []CuratedSignal
+	err := query.Order("signals.ts_published DESC").Scan(&rows).Error
+	return rows, err
+}
+
+func (s *gormStore) CreateSavedSearch(search *models.SavedSearch) error {
+	return s.db.Create(search).Error
+}
+
+func (s *gormStore) ListSavedSearches(owner string) ([]models.SavedSearch, error) {
+	query := s.db.Model(&models.SavedSearch{})
+	if owner != "" {
+		query = query.Where("owner = ?", owner)
+	}
+	var searches []models.SavedSearch
+	err := query.Find(&searches).Error
+	return searches, err
+}
+
+func (s *gormStore) ListNotifySavedSearches() ([]models.SavedSearch, error) {
+	var searches []models.SavedSearch
+	err := s.db.Where("notify = ?", true).Find(&searches).Error
+	return searches, err
+}
+
+func (s *gormStore) DeleteSavedSearch(id uint) error {
+	return s.db.Delete(&models.SavedSearch{}, id).Error
+}
+
+// withFilters applies the common sector/region/impact/confidence/date_from/
+// starred/tag filters and always excludes test signals. Starred/Tag pull in
+// a LEFT JOIN against curations since those fields live on that table.
+func (s *gormStore) withFilters(query *gorm.DB, filter FilterParams) *gorm.DB {
+	query = query.Where("is_test = ?", false)
+	if filter.Sector != "" {
+		query = query.Where("sector = ?", filter.Sector)
+	}
+	if filter.Region != "" {
+		query = query.Where("region = ?", filter.Region)
+	}
+	if filter.MinImpact > 0 {
+		query = query.Where("impact >= ?", filter.MinImpact)
+	}
+	if filter.MinConfidence > 0 {
+		query = query.Where("confidence >= ?", filter.MinConfidence)
+	}
+	if filter.DateFrom != "" {
+		query = query.Where("ts_published >= ?", filter.DateFrom)
+	}
+	if filter.Starred || filter.Tag != "" {
+		query = query.Joins("LEFT JOIN curations ON curations.signal_id = signals.id")
+		if filter.Starred {
+			query = query.Where("curations.starred = ?", true)
+		}
+		if filter.Tag != "" {
+			query = query.Where("curations.tags LIKE ?", "%"+filter.Tag+"%")
+		}
+	}
+	return query
+}
@@ -0,0 +1,212 @@
+package database
+
+import (
+	"strings"
+
+	"signal-analysis/models"
+)
+
+// MockStore is an in-memory Store for handler unit tests - no SQLite/Postgres
+// connection required. Filtering is intentionally simple (no partial/range
+// matching beyond what the handlers exercise).
+type MockStore struct {
+	Signals     []models.Signal
+	Curations   []models.Curation
+	SavedSearch []models.SavedSearch
+	nextSavedID uint
+}
+
+// NewMockStore seeds a MockStore with the given signals.
+func NewMockStore(signals ...models.Signal) *MockStore {
+	return &MockStore{Signals: signals}
+}
+
+func (m *MockStore) SignalByID(id string) (*models.Signal, error) {
+	for i := range m.Signals {
+		if m.Signals[i].ID == id {
+			return &m.Signals[i], nil
+		}
+	}
+	return nil, ErrSignalNotFound
+}
+
+func (m *MockStore) ListSignals(filter FilterParams, limit int) ([]models.Signal, error) {
+	var matched []models.Signal
+	for _, s := range m.Signals {
+		if s.IsTest {
+			continue
+		}
+		if filter.Sector != "" && s.Sector != filter.Sector {
+			continue
+		}
+		if filter.Region != "" && s.Region != filter.Region {
+			continue
+		}
+		if filter.MinImpact > 0 && s.Impact < filter.MinImpact {
+			continue
+		}
+		if filter.MinConfidence > 0 && s.Confidence < filter.MinConfidence {
+			continue
+		}
+		if filter.DateFrom != "" && s.TsPublished < filter.DateFrom {
+			continue
+		}
+		curation := m.curationFor(s.ID)
+		if filter.Starred && (curation == nil || !curation.Starred) {
+			continue
+		}
+		if filter.Tag != "" && (curation == nil || !strings.Contains(curation.Tags, filter.Tag)) {
+			continue
+		}
+		matched = append(matched, s)
+		if limit > 0 && len(matched) >= limit {
+			break
+		}
+	}
+	return matched, nil
+}
+
+func (m *MockStore) SaveAnalysis(signal *models.Signal) error {
+	for i := range m.Signals {
+		if m.Signals[i].ID == signal.ID {
+			m.Signals[i] = *signal
+			return nil
+		}
+	}
+	m.Signals = append(m.Signals, *signal)
+	return nil
+}
+
+func (m *MockStore) AggregateStats(filter FilterParams) (*Stats, error) {
+	signals, _ := m.ListSignals(filter, 0)
+
+	stats := &Stats{}
+	sectors := map[string]struct{}{}
+	var confidenceSum int
+
+	for _, s := range signals {
+		stats.Total++
+		confidenceSum += s.Confidence
+		sectors[s.Sector] = struct{}{}
+
+		switch {
+		case s.Impact >= 70:
+			stats.HighImpact++
+		case s.Impact >= 50:
+			stats.MediumImpact++
+		}
+		switch {
+		case s.Sentiment > 0:
+			stats.Bullish++
+		case s.Sentiment < 0:
+			stats.Bearish++
+		}
+	}
+
+	if stats.Total > 0 {
+		stats.AvgConfidence = float64(confidenceSum) / float64(stats.Total)
+	}
+	stats.Sectors = int64(len(sectors))
+	return stats, nil
+}
+
+func (m *MockStore) UpsertCuration(curation *models.Curation) error {
+	for i := range m.Curations {
+		if m.Curations[i].SignalID == curation.SignalID {
+			m.Curations[i] = *curation
+			return nil
+		}
+	}
+	m.Curations = append(m.Curations, *curation)
+	return nil
+}
+
+func (m *MockStore) curationFor(signalID string) *models.Curation {
+	for i := range m.Curations {
+		if m.Curations[i].SignalID == signalID {
+			return &m.Curations[i]
+		}
+	}
+	return nil
+}
+
+func (m *MockStore) CurationBySignalID(signalID string) (*models.Curation, error) {
+	if c := m.curationFor(signalID); c != nil {
+		return c, nil
+	}
+	return nil, ErrCurationNotFound
+}
+
+func (m *MockStore) DeleteCuration(signalID string) error {
+	for i := range m.Curations {
+		if m.Curations[i].SignalID == signalID {
+			m.Curations = append(m.Curations[:i], m.Curations[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (m *MockStore) ListCurations(filter FilterParams) ([]CuratedSignal, error) {
+	var rows []CuratedSignal
+	for _, s := range m.Signals {
+		if s.IsTest {
+			continue
+		}
+		curation := m.curationFor(s.ID)
+		if filter.Starred && (curation == nil || !curation.Starred) {
+			continue
+		}
+		if filter.Tag != "" && (curation == nil || !strings.Contains(curation.Tags, filter.Tag)) {
+			continue
+		}
+		row := CuratedSignal{Signal: s}
+		if curation != nil {
+			row.Starred = curation.Starred
+			row.Note = curation.Note
+			row.Tags = curation.Tags
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (m *MockStore) CreateSavedSearch(search *models.SavedSearch) error {
+	m.nextSavedID++
+	search.ID = m.nextSavedID
+	m.SavedSearch = append(m.SavedSearch, *search)
+	return nil
+}
+
+func (m *MockStore) ListSavedSearches(owner string) ([]models.SavedSearch, error) {
+	if owner == "" {
+		return m.SavedSearch, nil
+	}
+	var matched []models.SavedSearch
+	for _, s := range m.SavedSearch {
+		if s.Owner == owner {
+			matched = append(matched, s)
+		}
+	}
+	return matched, nil
+}
+
+func (m *MockStore) ListNotifySavedSearches() ([]models.SavedSearch, error) {
+	var matched []models.SavedSearch
+	for _, s := range m.SavedSearch {
+		if s.Notify {
+			matched = append(matched, s)
+		}
+	}
+	return matched, nil
+}
+
+func (m *MockStore) DeleteSavedSearch(id uint) error {
+	for i := range m.SavedSearch {
+		if m.SavedSearch[i].ID == id {
+			m.SavedSearch = append(m.SavedSearch[:i], m.SavedSearch[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package database
+
+import (
+	"testing"
+
+	"signal-analysis/models"
+)
+
+// TestMockStoreAggregateStats pins MockStore's aggregation to the same
+// bucket boundaries gormStore.AggregateStats computes in SQL (impact >= 70
+// is high, 50-69 is medium, sentiment sign determines bullish/bearish), so
+// the two implementations can't silently drift apart.
+func TestMockStoreAggregateStats(t *testing.T) {
+	store := NewMockStore(
+		models.Signal{ID: "1", Sector: "energy", Impact: 80, Confidence: 90, Sentiment: 1},
+		models.Signal{ID: "2", Sector: "tech", Impact: 60, Confidence: 70, Sentiment: -1},
+		models.Signal{ID: "3", Sector: "tech", Impact: 10, Confidence: 50, Sentiment: 0},
+		models.Signal{ID: "4", Sector: "tech", Impact: 90, Confidence: 100, IsTest: true},
+	)
+
+	stats, err := store.AggregateStats(FilterParams{})
+	if err != nil {
+		t.Fatalf("AggregateStats returned error: %v", err)
+	}
+
+	if stats.Total != 3 {
+		t.Errorf("Total = %d, want 3 (test signal excluded)", stats.Total)
+	}
+	if stats.HighImpact != 1 {
+		t.Errorf("HighImpact = %d, want 1", stats.HighImpact)
+	}
+	if stats.MediumImpact != 1 {
+		t.Errorf("MediumImpact = %d, want 1", stats.MediumImpact)
+	}
+	if stats.Bullish != 1 || stats.Bearish != 1 {
+		t.Errorf("Bullish/Bearish = %d/%d, want 1/1", stats.Bullish, stats.Bearish)
+	}
+	if stats.Sectors != 2 {
+		t.Errorf("Sectors = %d, want 2", stats.Sectors)
+	}
+	wantAvg := float64(90+70+50) / 3
+	if stats.AvgConfidence != wantAvg {
+		t.Errorf("AvgConfidence = %v, want %v", stats.AvgConfidence, wantAvg)
+	}
+}
+
+func TestMockStoreSavedSearches(t *testing.T) {
+	store := NewMockStore()
+
+	if err := store.CreateSavedSearch(&models.SavedSearch{Name: "oil", Owner: "alice", Notify: true}); err != nil {
+		t.Fatalf("CreateSavedSearch: %v", err)
+	}
+	if err := store.CreateSavedSearch(&models.SavedSearch{Name: "gas", Owner: "bob"}); err != nil {
+		t.Fatalf("CreateSavedSearch: %v", err)
+	}
+
+	all, err := store.ListSavedSearches("")
+	if err != nil || len(all) != 2 {
+		t.Fatalf("ListSavedSearches(\"\") = %v, %v, want 2 results", all, err)
+	}
+
+	owned, err := store.ListSavedSearches("alice")
+	if err != nil || len(owned) != 1 || owned[0].Name != "oil" {
+		t.Fatalf("ListSavedSearches(\"alice\") = %v, %v, want [oil]", owned, err)
+	}
+
+	notify, err := store.ListNotifySavedSearches()
+	if err != nil || len(notify) != 1 || notify[0].Name != "oil" {
+		t.Fatalf("ListNotifySavedSearches() = %v, %v, want [oil]", notify, err)
+	}
+
+	if err := store.DeleteSavedSearch(owned[0].ID); err != nil {
+		t.Fatalf("DeleteSavedSearch: %v", err)
+	}
+	if remaining, _ := store.ListSavedSearches(""); len(remaining) != 1 {
+		t.Fatalf("expected 1 saved search left after delete, got %d", len(remaining))
+	}
+}
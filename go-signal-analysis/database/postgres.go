@@ -0,0 +1,45 @@
+package database
+
+import (
+	"log"
+
+	"signal-analysis/models"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// postgresIndices mirrors the lookups handlers actually filter/sort on.
+// is_test_excluded is a partial index (Postgres-only) so the much more
+// common "exclude test rows" queries skip them at the index level instead
+// of filtering them out row by row.
+var postgresIndices = []string{
+	`CREATE INDEX IF NOT EXISTS idx_signals_ts_published ON signals (ts_published DESC)`,
+	`CREATE INDEX IF NOT EXISTS idx_signals_sector ON signals (sector)`,
+	`CREATE INDEX IF NOT EXISTS idx_signals_region ON signals (region)`,
+	`CREATE INDEX IF NOT EXISTS idx_signals_impact ON signals (impact)`,
+	`CREATE INDEX IF NOT EXISTS idx_signals_is_test_excluded ON signals (ts_published DESC) WHERE is_test = false`,
+}
+
+// NewPostgresStore opens a GORM Postgres connection using dsn, migrates the
+// schema and ensures the indices the handlers rely on exist, then returns
+// both the raw *gorm.DB (for the handful of callers that still need one,
+// mirroring GetDB()) and it wrapped as a Store.
+func NewPostgresStore(dsn string) (*gorm.DB, Store, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := db.AutoMigrate(&models.Signal{}, &models.Curation{}, &models.SavedSearch{}); err != nil {
+		return nil, nil, err
+	}
+
+	for _, stmt := range postgresIndices {
+		if err := db.Exec(stmt).Error; err != nil {
+			log.Printf("failed to create index (%s): %v", stmt, err)
+		}
+	}
+
+	return db, &gormStore{db: db}, nil
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"errors"
+
+	"signal-analysis/models"
+)
+
+// FilterParams narrows ListSignals/AggregateStats to a sector, region,
+// minimum impact/confidence, a published-after date, and/or curation state.
+// Zero values mean "no filter" for that field.
+type FilterParams struct {
+	Sector        string
+	Region        string
+	MinImpact     int
+	MinConfidence int
+	DateFrom      string
+	Starred       bool
+	Tag           string
+}
+
+// Stats is the aggregate view rendered by GET /api/stats and the dashboard.
+type Stats struct {
+	Total         int64
+	HighImpact    int64
+	MediumImpact  int64
+	AvgConfidence float64
+	Bullish       int64
+	Bearish       int64
+	Sectors       int64
+}
+
+// CuratedSignal is a signal joined with its curation state, returned by
+// ListCurations.
+type CuratedSignal struct {
+	models.Signal
+	Starred bool   `json:"starred"`
+	Note    string `json:"note"`
+	Tags    string `json:"tags"`
+}
+
+// Store is every database operation the handlers package needs, so handlers
+// depend on this interface instead of reaching into GetDB() for a raw
+// *gorm.DB. A SQLite-backed and a Postgres-backed implementation share this
+// contract, and MockStore satisfies it for handler unit tests.
+type Store interface {
+	SignalByID(id string) (*models.Signal, error)
+	ListSignals(filter FilterParams, limit int) ([]models.Signal, error)
+	SaveAnalysis(signal *models.Signal) error
+	AggregateStats(filter FilterParams) (*Stats, error)
+
+	UpsertCuration(curation *models.Curation) error
+	CurationBySignalID(signalID string) (*models.Curation, error)
+	DeleteCuration(signalID string) error
+	ListCurations(filter FilterParams) ([]CuratedSignal, error)
+
+	CreateSavedSearch(search *models.SavedSearch) error
+	ListSavedSearches(owner string) ([]models.SavedSearch, error)
+	ListNotifySavedSearches() ([]models.SavedSearch, error)
+	DeleteSavedSearch(id uint) error
+}
+
+// ErrCurationNotFound is returned by CurationBySignalID when no row matches.
+var ErrCurationNotFound = errors.New("curation not found")
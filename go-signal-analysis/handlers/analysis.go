@@ -1,40 +1,37 @@
 package handlers
 
 import (
-	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"os"
+
+	"signal-analysis/analysis"
 	"signal-analysis/database"
-	"signal-analysis/models"
-	"time"
+	"signal-analysis/notify"
+	"signal-analysis/search"
+	"signal-analysis/sse"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
-type AnalysisRequest struct {
-	Language string `json:"language"`
-}
-
-type DeepSeekRequest struct {
-	Model    string    `json:"model"`
-	Messages []Message `json:"messages"`
-}
-
-type Message struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
-}
+// provider is the process-wide LLM provider, built from env vars at startup
+// via SetProvider. Analogous to database.DB: a package-level handle the
+// handlers reach into instead of constructing one per request.
+var provider analysis.Provider
 
-type DeepSeekResponse struct {
-	Choices []Choice `json:"choices"`
+// SetProvider wires up the LLM provider used by GenerateAnalysis,
+// GenerateAnalysisPage and StreamAnalysis. Call once at startup.
+func SetProvider(p analysis.Provider) {
+	provider = p
 }
 
-type Choice struct {
-	Message Message `json:"message"`
+type AnalysisRequest struct {
+	Language string `json:"language"`
+	// Style selects an analyst style template ("risk-focused", ...) in
+	// place of the default per-language template. Empty keeps the
+	// language-based default.
+	Style string `json:"style"`
 }
 
 func GenerateAnalysis(c *gin.Context) {
@@ -46,12 +43,10 @@ func GenerateAnalysis(c *gin.Context) {
 		return
 	}
 
-	db := database.GetDB()
-	var signal models.Signal
-
 	// Найти сигнал
-	if err := db.Where("id = ?", signalID).First(&signal).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
+	signal, err := store.SignalByID(signalID)
+	if err != nil {
+		if errors.Is(err, database.ErrSignalNotFound) {
 			c.JSON(404, gin.H{"error": "Signal not found"})
 			return
 		}
@@ -59,122 +54,96 @@ func GenerateAnalysis(c *gin.Context) {
 		return
 	}
 
-	// Генерируем аналитику через DeepSeek
-	analysis, err := callDeepSeek(signal, request.Language)
+	// Генерируем аналитику через выбранный LLM-провайдер
+	result, err := provider.GenerateAnalysis(c.Request.Context(), *signal, request.Language, request.Style)
 	if err != nil {
 		c.JSON(500, gin.H{"error": fmt.Sprintf("Analysis generation failed: %v", err)})
 		return
 	}
 
 	// Сохраняем аналитику в базу
-	signal.Analysis = analysis
+	signal.Analysis = result
 
-	if err := db.Save(&signal).Error; err != nil {
+	if err := store.SaveAnalysis(signal); err != nil {
 		c.JSON(500, gin.H{"error": "Failed to save analysis"})
 		return
 	}
 
-	c.JSON(200, gin.H{"analysis": analysis})
-}
+	// Обновляем поисковый индекс (no-op если ES не настроен)
+	search.Upsert(*signal)
 
-func callDeepSeek(signal models.Signal, language string) (string, error) {
-	apiKey := os.Getenv("DEEPSEEK_API_KEY")
-	if apiKey == "" {
-		return "", fmt.Errorf("DEEPSEEK_API_KEY not configured")
-	}
+	// Уведомляем подключенных клиентов по SSE
+	sse.Default().Broadcast(*signal, "analysis")
 
-	// Создаем промпт в зависимости от языка
-	var prompt string
-	if language == "ru" {
-		prompt = createRussianPrompt(signal)
-	} else {
-		prompt = createEnglishPrompt(signal)
-	}
+	// Проверяем сохранённые поиски с notify=true
+	_ = notify.EvaluateAndNotify(store, *signal)
 
-	// Подготавливаем запрос к DeepSeek
-	requestBody := DeepSeekRequest{
-		Model: "deepseek-chat",
-		Messages: []Message{
-			{
-				Role:    "user",
-				Content: prompt,
-			},
-		},
-	}
+	c.JSON(200, gin.H{"analysis": result})
+}
 
-	jsonData, err := json.Marshal(requestBody)
-	if err != nil {
-		return "", err
-	}
+// StreamAnalysis handles POST /api/generate-analysis/:signal_id/stream,
+// proxying the provider's token-by-token output to the client over SSE and
+// saving the assembled analysis once the stream completes.
+func StreamAnalysis(c *gin.Context) {
+	signalID := c.Param("signal_id")
+	language := c.DefaultQuery("lang", "en")
+	style := c.Query("style")
 
-	// Отправляем запрос
-	req, err := http.NewRequest("POST", "https://api.deepseek.com/v1/chat/completions", bytes.NewBuffer(jsonData))
+	signal, err := store.SignalByID(signalID)
 	if err != nil {
-		return "", err
+		if errors.Is(err, database.ErrSignalNotFound) {
+			c.JSON(404, gin.H{"error": "Signal not found"})
+			return
+		}
+		c.JSON(500, gin.H{"error": "Database error"})
+		return
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
+	chunks, err := provider.StreamAnalysis(c.Request.Context(), *signal, language, style)
 	if err != nil {
-		return "", err
+		c.JSON(500, gin.H{"error": fmt.Sprintf("Analysis streaming failed: %v", err)})
+		return
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("DeepSeek API error: %s", string(body))
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.WriteHeader(http.StatusOK)
+	c.Writer.Flush()
+
+	var full string
+	for chunk := range chunks {
+		if chunk.Err != nil {
+			fmt.Fprintf(c.Writer, "event: error\ndata: %s\n\n", chunk.Err.Error())
+			c.Writer.Flush()
+			return
+		}
+		full += chunk.Content
+		fmt.Fprintf(c.Writer, "data: %s\n\n", jsonEscape(chunk.Content))
+		c.Writer.Flush()
 	}
-
-	// Парсим ответ
-	var response DeepSeekResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return "", err
+	if c.Request.Context().Err() != nil {
+		// Клиент отключился до конца потока - не сохраняем обрезанный текст
+		return
 	}
 
-	if len(response.Choices) == 0 {
-		return "", fmt.Errorf("no response from DeepSeek")
+	signal.Analysis = full
+	if err := store.SaveAnalysis(signal); err != nil {
+		fmt.Fprintf(c.Writer, "event: error\ndata: failed to save analysis\n\n")
+		c.Writer.Flush()
+		return
 	}
+	search.Upsert(*signal)
+	sse.Default().Broadcast(*signal, "analysis")
+	_ = notify.EvaluateAndNotify(store, *signal)
 
-	return response.Choices[0].Message.Content, nil
-}
-
-func createEnglishPrompt(signal models.Signal) string {
-	return fmt.Sprintf(`Analyze this financial news and provide professional investment analysis:
-
-Title: %s
-Summary: %s
-Sector: %s
-Region: %s
-Impact: %d
-Confidence: %d%%
-
-Provide analysis in English with:
-1. Market Impact Assessment (100-150 words)
-2. Industry Implications & Risk Factors (100-150 words)  
-3. Investment Opportunities & Key Metrics (100-150 words)
-
-Format as professional Bloomberg/Reuters style analysis.`,
-		signal.Title, signal.Summary, signal.Sector, signal.Region, signal.Impact, signal.Confidence)
+	fmt.Fprint(c.Writer, "event: done\ndata: {}\n\n")
+	c.Writer.Flush()
 }
 
-func createRussianPrompt(signal models.Signal) string {
-	return fmt.Sprintf(`Проанализируйте эту финансовую новость и предоставьте профессиональный инвестиционный анализ:
-
-Заголовок: %s
-Краткое содержание: %s
-Сектор: %s
-Регион: %s
-Влияние: %d
-Достоверность: %d%%
-
-Предоставьте анализ на русском языке:
-1. Оценка влияния на рынок (100-150 слов)
-2. Последствия для отрасли и факторы риска (100-150 слов)
-3. Инвестиционные возможности и ключевые метрики (100-150 слов)
-
-Оформите как профессиональный анализ в стиле Bloomberg/Reuters.`,
-		signal.Title, signal.Summary, signal.Sector, signal.Region, signal.Impact, signal.Confidence)
+// jsonEscape keeps an SSE data frame on a single line, since raw newlines in
+// content would otherwise terminate the frame early.
+func jsonEscape(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
 }
@@ -1,24 +1,26 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+
 	"signal-analysis/database"
-	"signal-analysis/models"
+	"signal-analysis/notify"
+	"signal-analysis/search"
+	"signal-analysis/sse"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
 func GenerateAnalysisPage(c *gin.Context) {
 	signalID := c.Param("signal_id")
 	language := c.DefaultQuery("lang", "en")
-
-	db := database.GetDB()
-	var signal models.Signal
+	style := c.Query("style")
 
 	// Найти сигнал
-	if err := db.Where("id = ?", signalID).First(&signal).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
+	signal, err := store.SignalByID(signalID)
+	if err != nil {
+		if errors.Is(err, database.ErrSignalNotFound) {
 			c.HTML(http.StatusNotFound, "error.html", gin.H{"error": "Signal not found"})
 			return
 		}
@@ -26,25 +28,30 @@ func GenerateAnalysisPage(c *gin.Context) {
 		return
 	}
 
-	// Генерируем аналитику через DeepSeek
-	analysis, err := callDeepSeek(signal, language)
+	// Генерируем аналитику через выбранный LLM-провайдер
+	result, err := provider.GenerateAnalysis(c.Request.Context(), *signal, language, style)
 	if err != nil {
 		c.HTML(http.StatusInternalServerError, "error.html", gin.H{"error": "Analysis generation failed"})
 		return
 	}
 
 	// Сохраняем аналитику в базу
-	signal.Analysis = analysis
+	signal.Analysis = result
 
-	if err := db.Save(&signal).Error; err != nil {
+	if err := store.SaveAnalysis(signal); err != nil {
 		c.HTML(http.StatusInternalServerError, "error.html", gin.H{"error": "Failed to save analysis"})
 		return
 	}
 
-	// Перенаправляем обратно на дашборд
-	c.Redirect(http.StatusSeeOther, "/dashboard?lang="+language)
-}
-
+	// Обновляем поисковый индекс (no-op если ES не настроен)
+	search.Upsert(*signal)
 
+	// Уведомляем подключенных клиентов по SSE
+	sse.Default().Broadcast(*signal, "analysis")
 
+	// Проверяем сохранённые поиски с notify=true
+	_ = notify.EvaluateAndNotify(store, *signal)
 
+	// Перенаправляем обратно на дашборд
+	c.Redirect(http.StatusSeeOther, "/dashboard?lang="+language)
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"signal-analysis/database"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestGenerateAnalysis_SignalNotFound(t *testing.T) {
+	store = database.NewMockStore()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/generate-analysis/missing", strings.NewReader(`{}`))
+	c.Params = gin.Params{{Key: "signal_id", Value: "missing"}}
+
+	GenerateAnalysis(c)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestGenerateAnalysis_InvalidBody(t *testing.T) {
+	store = database.NewMockStore()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/generate-analysis/missing", strings.NewReader(`not-json`))
+	c.Params = gin.Params{{Key: "signal_id", Value: "missing"}}
+
+	GenerateAnalysis(c)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"signal-analysis/database"
+	"signal-analysis/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+type curationRequest struct {
+	SignalID string `json:"signal_id"`
+	Starred  bool   `json:"starred"`
+	Note     string `json:"note"`
+	Tags     string `json:"tags"`
+}
+
+// CreateCuration handles POST /api/curations.
+func CreateCuration(c *gin.Context) {
+	var req curationRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.SignalID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "signal_id is required"})
+		return
+	}
+	upsertCuration(c, req)
+}
+
+// UpdateCuration handles PATCH /api/curations/:signal_id.
+func UpdateCuration(c *gin.Context) {
+	var req curationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+	req.SignalID = c.Param("signal_id")
+	upsertCuration(c, req)
+}
+
+// DeleteCuration handles DELETE /api/curations/:signal_id.
+func DeleteCuration(c *gin.Context) {
+	signalID := c.Param("signal_id")
+	if err := store.DeleteCuration(signalID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"deleted": signalID})
+}
+
+// GetCuration handles GET /api/curations/:signal_id.
+func GetCuration(c *gin.Context) {
+	signalID := c.Param("signal_id")
+	curation, err := store.CurationBySignalID(signalID)
+	if err != nil {
+		if errors.Is(err, database.ErrCurationNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "curation not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, curation)
+}
+
+// ListCurations handles GET /api/curations?starred=true&tag=oil, returning
+// every non-test signal LEFT JOINed with its curation state.
+func ListCurations(c *gin.Context) {
+	starred, _ := strconv.ParseBool(c.Query("starred"))
+	filter := database.FilterParams{Starred: starred, Tag: c.Query("tag")}
+
+	rows, err := store.ListCurations(filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, rows)
+}
+
+// ExportCurations handles GET /api/curations/export?format=csv|json&starred=true&tag=oil.
+func ExportCurations(c *gin.Context) {
+	starred, _ := strconv.ParseBool(c.Query("starred"))
+	filter := database.FilterParams{Starred: starred, Tag: c.Query("tag")}
+
+	rows, err := store.ListCurations(filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if c.Query("format") == "csv" {
+		writeCurationsCSV(c, rows)
+		return
+	}
+	c.JSON(http.StatusOK, rows)
+}
+
+func writeCurationsCSV(c *gin.Context, rows []database.CuratedSignal) {
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", `attachment; filename="curated_signals.csv"`)
+
+	w := csv.NewWriter(c.Writer)
+	defer w.Flush()
+
+	_ = w.Write([]string{"id", "title", "sector", "region", "impact", "confidence", "starred", "note", "tags"})
+	for _, r := range rows {
+		_ = w.Write([]string{
+			r.ID,
+			r.Title,
+			r.Sector,
+			r.Region,
+			strconv.Itoa(r.Impact),
+			strconv.Itoa(r.Confidence),
+			strconv.FormatBool(r.Starred),
+			r.Note,
+			r.Tags,
+		})
+	}
+}
+
+func upsertCuration(c *gin.Context, req curationRequest) {
+	curation := &models.Curation{
+		SignalID: req.SignalID,
+		Starred:  req.Starred,
+		Note:     req.Note,
+		Tags:     req.Tags,
+	}
+	if err := store.UpsertCuration(curation); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, curation)
+}
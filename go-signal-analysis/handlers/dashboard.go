@@ -11,16 +11,22 @@ import (
 
 type DashboardData struct {
 	Language string
-	Filters  FilterParams
+	Filters  DashboardFilters
 	Signals  []models.Signal
 	Stats    *StatsData
 }
 
-type FilterParams struct {
+// DashboardFilters is the view-layer mirror of database.FilterParams, kept
+// under the template's original field names (Impact/Confidence instead of
+// MinImpact/MinConfidence) so dashboard.html doesn't need to change when the
+// query-layer fields are renamed.
+type DashboardFilters struct {
 	Sector     string
 	Region     string
 	Impact     int
 	Confidence int
+	Starred    bool
+	Tag        string
 }
 
 type StatsData struct {
@@ -38,105 +44,59 @@ func Dashboard(c *gin.Context) {
 	region := c.Query("region")
 	impact, _ := strconv.Atoi(c.DefaultQuery("impact", "0"))
 	confidence, _ := strconv.Atoi(c.DefaultQuery("confidence", "0"))
+	starred, _ := strconv.ParseBool(c.Query("starred"))
+	tag := c.Query("tag")
 	language := c.DefaultQuery("lang", "en")
 
+	filter := database.FilterParams{
+		Sector:        sector,
+		Region:        region,
+		MinImpact:     impact,
+		MinConfidence: confidence,
+		Starred:       starred,
+		Tag:           tag,
+	}
+
 	// Загружаем сигналы
-	signals := loadSignals(sector, region, impact, confidence)
+	signals, err := store.ListSignals(filter, 50)
+	if err != nil {
+		c.HTML(http.StatusInternalServerError, "error.html", gin.H{"error": "Database error"})
+		return
+	}
 
 	// Загружаем статистику ТОЛЬКО если есть фильтры
-	var stats *StatsData
-	if sector != "" || region != "" || impact > 0 || confidence > 0 {
-		stats = loadStats(sector, region, impact, confidence)
+	var statsData *StatsData
+	if sector != "" || region != "" || impact > 0 || confidence > 0 || starred || tag != "" {
+		stats, err := store.AggregateStats(filter)
+		if err != nil {
+			c.HTML(http.StatusInternalServerError, "error.html", gin.H{"error": "Database error"})
+			return
+		}
+		statsData = &StatsData{
+			Total:         stats.Total,
+			HighImpact:    stats.HighImpact,
+			MediumImpact:  stats.MediumImpact,
+			AvgConfidence: stats.AvgConfidence,
+			Bullish:       stats.Bullish,
+			Bearish:       stats.Bearish,
+		}
 	}
 
 	// Подготавливаем данные для шаблона
 	data := DashboardData{
 		Language: language,
-		Filters: FilterParams{
+		Filters: DashboardFilters{
 			Sector:     sector,
 			Region:     region,
 			Impact:     impact,
 			Confidence: confidence,
+			Starred:    starred,
+			Tag:        tag,
 		},
 		Signals: signals,
-		Stats:   stats,
+		Stats:   statsData,
 	}
 
 	// Рендерим HTML
 	c.HTML(http.StatusOK, "dashboard.html", data)
 }
-
-func loadSignals(sector, region string, impact, confidence int) []models.Signal {
-	db := database.GetDB()
-
-	query := db.Model(&models.Signal{})
-
-	// ВСЕГДА исключаем тестовые сигналы
-	query = query.Where("is_test = ?", false)
-
-	// Фильтры - только если параметры переданы
-	if sector != "" {
-		query = query.Where("sector = ?", sector)
-	}
-	if region != "" {
-		query = query.Where("region = ?", region)
-	}
-	if impact > 0 {
-		query = query.Where("impact >= ?", impact)
-	}
-	if confidence > 0 {
-		query = query.Where("confidence >= ?", confidence)
-	}
-
-	// Сортировка и лимит
-	var signals []models.Signal
-	query.Order("ts_published DESC").Limit(50).Find(&signals)
-
-	return signals
-}
-
-func loadStats(sector, region string, impact, confidence int) *StatsData {
-	db := database.GetDB()
-
-	// Строим запрос с теми же фильтрами что и для сигналов
-	query := db.Model(&models.Signal{})
-
-	// ВСЕГДА исключаем тестовые сигналы
-	query = query.Where("is_test = ?", false)
-
-	// Фильтры - только если параметры переданы
-	if sector != "" {
-		query = query.Where("sector = ?", sector)
-	}
-	if region != "" {
-		query = query.Where("region = ?", region)
-	}
-	if impact > 0 {
-		query = query.Where("impact >= ?", impact)
-	}
-	if confidence > 0 {
-		query = query.Where("confidence >= ?", confidence)
-	}
-
-	var stats StatsData
-
-	// Общее количество с фильтрами
-	query.Count(&stats.Total)
-
-	// Высокое влияние (70+) с фильтрами
-	query.Where("impact >= ?", 70).Count(&stats.HighImpact)
-
-	// Среднее влияние (50-69) с фильтрами
-	query.Where("impact >= ? AND impact < ?", 50, 70).Count(&stats.MediumImpact)
-
-	// Средняя достоверность с фильтрами
-	query.Select("AVG(confidence)").Scan(&stats.AvgConfidence)
-
-	// Бычьи сигналы с фильтрами
-	query.Where("sentiment > ?", 0).Count(&stats.Bullish)
-
-	// Медвежьи сигналы с фильтрами
-	query.Where("sentiment < ?", 0).Count(&stats.Bearish)
-
-	return &stats
-}
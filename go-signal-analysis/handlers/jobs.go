@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+
+	"signal-analysis/worker"
+
+	"github.com/gin-gonic/gin"
+)
+
+// scheduler is the process-wide worker.Scheduler, wired up via SetScheduler.
+var scheduler *worker.Scheduler
+
+// SetScheduler wires up the Scheduler used by GetJobs. Call once at startup.
+func SetScheduler(s *worker.Scheduler) {
+	scheduler = s
+}
+
+// GetJobs handles GET /api/jobs, reporting each background job's last run,
+// duration, next scheduled fire, and error (if any).
+func GetJobs(c *gin.Context) {
+	if scheduler == nil {
+		c.JSON(http.StatusOK, []worker.JobStatus{})
+		return
+	}
+	c.JSON(http.StatusOK, scheduler.Statuses())
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"signal-analysis/database"
+	"signal-analysis/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+type savedSearchRequest struct {
+	Name   string                `json:"name"`
+	Filter database.FilterParams `json:"filter"`
+	Owner  string                `json:"owner"`
+	Notify bool                  `json:"notify"`
+}
+
+// CreateSavedSearch handles POST /api/saved-searches, persisting a filter
+// preset an analyst can re-run or have evaluated against new signals.
+func CreateSavedSearch(c *gin.Context) {
+	var req savedSearchRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+		return
+	}
+
+	filterJSON, err := json.Marshal(req.Filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	search := &models.SavedSearch{
+		Name:       req.Name,
+		FilterJSON: string(filterJSON),
+		Owner:      req.Owner,
+		Notify:     req.Notify,
+	}
+	if err := store.CreateSavedSearch(search); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, search)
+}
+
+// ListSavedSearches handles GET /api/saved-searches?owner=analyst@example.com.
+func ListSavedSearches(c *gin.Context) {
+	searches, err := store.ListSavedSearches(c.Query("owner"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, searches)
+}
+
+// DeleteSavedSearch handles DELETE /api/saved-searches/:id.
+func DeleteSavedSearch(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+	if err := store.DeleteSavedSearch(uint(id)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"deleted": id})
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"signal-analysis/database"
+	"signal-analysis/models"
+	"signal-analysis/search"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetSearch handles GET /api/search?q=...&sector=...&region=...&from=...&size=...
+// It queries Elasticsearch when available and falls back to a plain SQL LIKE
+// search over the configured database otherwise.
+func GetSearch(c *gin.Context) {
+	q := c.Query("q")
+	size, _ := strconv.Atoi(c.DefaultQuery("size", "20"))
+
+	params := search.Params{
+		Query:  q,
+		Sector: c.Query("sector"),
+		Region: c.Query("region"),
+		From:   c.Query("from"),
+		Size:   size,
+	}
+
+	result, err := search.Search(c.Request.Context(), params)
+	if err == nil {
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
+	// ES недоступен - деградируем до LIKE-поиска по базе данных
+	signals, fallbackErr := likeSearch(params)
+	if fallbackErr != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallbackErr.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"hits": signals, "degraded": true})
+}
+
+func likeSearch(p search.Params) ([]models.Signal, error) {
+	db := database.GetDB()
+	query := db.Model(&models.Signal{}).Where("is_test = ?", false)
+
+	if p.Query != "" {
+		like := "%" + p.Query + "%"
+		query = query.Where(
+			"title_clean LIKE ? OR title_ru LIKE ? OR summary LIKE ? OR analysis LIKE ?",
+			like, like, like, like,
+		)
+	}
+	if p.Sector != "" {
+		query = query.Where("sector = ?", p.Sector)
+	}
+	if p.Region != "" {
+		query = query.Where("region = ?", p.Region)
+	}
+	if p.From != "" {
+		query = query.Where("ts_published >= ?", p.From)
+	}
+
+	size := p.Size
+	if size <= 0 {
+		size = 20
+	}
+
+	var signals []models.Signal
+	err := query.Order("ts_published DESC").Limit(size).Find(&signals).Error
+	return signals, err
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"signal-analysis/database"
+	"signal-analysis/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestGetSignals_FiltersByMinImpact(t *testing.T) {
+	store = database.NewMockStore(
+		models.Signal{ID: "1", Sector: "energy", Impact: 80},
+		models.Signal{ID: "2", Sector: "energy", Impact: 20},
+	)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/signals?min_impact=50", nil)
+
+	GetSignals(c)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	body := w.Body.String()
+	if !strings.Contains(body, `"id":"1"`) || strings.Contains(body, `"id":"2"`) {
+		t.Fatalf("expected only signal 1 in response, got %s", body)
+	}
+}
+
+func TestGetStats_AggregatesAcrossNonTestSignals(t *testing.T) {
+	store = database.NewMockStore(
+		models.Signal{ID: "1", Sector: "energy", Impact: 80, Confidence: 90, Sentiment: 1},
+		models.Signal{ID: "2", Sector: "tech", Impact: 60, Confidence: 70, Sentiment: -1},
+		models.Signal{ID: "3", Sector: "tech", Impact: 10, Confidence: 50, Sentiment: 0, IsTest: true},
+	)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/stats", nil)
+
+	GetStats(c)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	body := w.Body.String()
+	for _, want := range []string{`"total":2`, `"high_impact":1`, `"medium_impact":1`, `"sectors":2`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected response to contain %q, got %s", want, body)
+		}
+	}
+}
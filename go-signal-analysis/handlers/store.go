@@ -0,0 +1,14 @@
+package handlers
+
+import "signal-analysis/database"
+
+// store is the process-wide database.Store, wired up in main via SetStore.
+// Handlers depend on this interface instead of calling database.GetDB()
+// directly, which is what lets handler tests swap in a database.MockStore.
+var store database.Store
+
+// SetStore wires up the Store used by the signal/dashboard/analysis handlers.
+// Call once at startup.
+func SetStore(s database.Store) {
+	store = s
+}
@@ -2,8 +2,12 @@ package main
 
 import (
 	"log"
+	"signal-analysis/analysis"
 	"signal-analysis/database"
 	"signal-analysis/handlers"
+	"signal-analysis/search"
+	"signal-analysis/sse"
+	"signal-analysis/worker"
 
 	"github.com/gin-gonic/gin"
 )
@@ -11,6 +15,27 @@ import (
 func main() {
 	// Инициализация базы данных
 	database.InitDB()
+	handlers.SetStore(database.GetStore())
+
+	// Подключение к Elasticsearch (опционально, деградирует до SQL при недоступности)
+	search.Init()
+	defer search.Close()
+
+	// Загружаем шаблоны промптов и выбираем LLM-провайдер (LLM_PROVIDER/LLM_PROVIDERS)
+	if err := analysis.LoadPrompts(analysis.PromptsDir); err != nil {
+		log.Fatal("Failed to load prompt templates:", err)
+	}
+	llmProvider, err := analysis.NewProviderFromEnv()
+	if err != nil {
+		log.Fatal("Failed to configure LLM provider:", err)
+	}
+	handlers.SetProvider(llmProvider)
+
+	// Запускаем фоновые задачи: поллинг фидов, авто-аналитику, пересчёт
+	// TrustScore и чистку тестовых данных (каждая отключаема через WORKER_*)
+	jobScheduler := worker.Start(worker.ConfigFromEnv(), database.GetStore(), llmProvider)
+	defer jobScheduler.Stop()
+	handlers.SetScheduler(jobScheduler)
 
 	// Настройка Gin
 	gin.SetMode(gin.ReleaseMode)
@@ -35,7 +60,23 @@ func main() {
 	{
 		api.GET("/signals", handlers.GetSignals)
 		api.GET("/stats", handlers.GetStats)
+		api.GET("/search", handlers.GetSearch)
 		api.POST("/generate-analysis/:signal_id", handlers.GenerateAnalysis)
+		api.POST("/generate-analysis/:signal_id/stream", handlers.StreamAnalysis)
+		api.GET("/stream/signals", sse.Default().ServeHTTP)
+
+		api.GET("/curations", handlers.ListCurations)
+		api.GET("/curations/export", handlers.ExportCurations)
+		api.POST("/curations", handlers.CreateCuration)
+		api.GET("/curations/:signal_id", handlers.GetCuration)
+		api.PATCH("/curations/:signal_id", handlers.UpdateCuration)
+		api.DELETE("/curations/:signal_id", handlers.DeleteCuration)
+
+		api.POST("/saved-searches", handlers.CreateSavedSearch)
+		api.GET("/saved-searches", handlers.ListSavedSearches)
+		api.DELETE("/saved-searches/:id", handlers.DeleteSavedSearch)
+
+		api.GET("/jobs", handlers.GetJobs)
 	}
 
 	// Генерация аналитики
@@ -31,3 +31,13 @@ type Curation struct {
 	Note     string `json:"note"`
 	Tags     string `json:"tags"`
 }
+
+// SavedSearch persists a filter preset an analyst can re-run, optionally
+// evaluating every newly ingested signal against it and notifying Owner.
+type SavedSearch struct {
+	ID         uint   `json:"id" gorm:"primaryKey"`
+	Name       string `json:"name"`
+	FilterJSON string `json:"filter_json"`
+	Owner      string `json:"owner" gorm:"index"`
+	Notify     bool   `json:"notify"`
+}
@@ -0,0 +1,155 @@
+// Package notify evaluates newly ingested signals against saved searches
+// and delivers a webhook (and, if configured, an email) to the owner of any
+// saved search that matches.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+
+	"signal-analysis/database"
+	"signal-analysis/models"
+)
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// EvaluateAndNotify checks signal against every notify-enabled saved search
+// and fires a webhook (plus email, if SMTP is configured) for each match.
+// Intended to be called once per newly ingested/updated signal.
+func EvaluateAndNotify(store database.Store, signal models.Signal) error {
+	searches, err := store.ListNotifySavedSearches()
+	if err != nil {
+		return fmt.Errorf("list saved searches: %w", err)
+	}
+
+	for _, s := range searches {
+		var filter database.FilterParams
+		if err := json.Unmarshal([]byte(s.FilterJSON), &filter); err != nil {
+			continue // сохранённый фильтр повреждён - пропускаем, не прерываем остальных
+		}
+		if !matches(store, signal, filter) {
+			continue
+		}
+		notifyOwner(s, signal)
+	}
+	return nil
+}
+
+// matches mirrors database.gormStore's filter semantics so a saved search
+// behaves the same whether it's run live or evaluated against a new signal.
+func matches(store database.Store, signal models.Signal, filter database.FilterParams) bool {
+	if signal.IsTest {
+		return false
+	}
+	if filter.Sector != "" && signal.Sector != filter.Sector {
+		return false
+	}
+	if filter.Region != "" && signal.Region != filter.Region {
+		return false
+	}
+	if filter.MinImpact > 0 && signal.Impact < filter.MinImpact {
+		return false
+	}
+	if filter.MinConfidence > 0 && signal.Confidence < filter.MinConfidence {
+		return false
+	}
+	if filter.DateFrom != "" && signal.TsPublished < filter.DateFrom {
+		return false
+	}
+	if filter.Starred || filter.Tag != "" {
+		curation, err := store.CurationBySignalID(signal.ID)
+		if err != nil {
+			return false
+		}
+		if filter.Starred && !curation.Starred {
+			return false
+		}
+		if filter.Tag != "" && !strings.Contains(curation.Tags, filter.Tag) {
+			return false
+		}
+	}
+	return true
+}
+
+func notifyOwner(search models.SavedSearch, signal models.Signal) {
+	if err := sendWebhook(search, signal); err != nil {
+		fmt.Printf("notify: webhook failed for saved search %d: %v\n", search.ID, err)
+	}
+	if err := sendEmail(search, signal); err != nil {
+		fmt.Printf("notify: email failed for saved search %d: %v\n", search.ID, err)
+	}
+}
+
+// sendWebhook POSTs the signal to NOTIFY_WEBHOOK_URL. No-op if unset.
+func sendWebhook(search models.SavedSearch, signal models.Signal) error {
+	url := os.Getenv("NOTIFY_WEBHOOK_URL")
+	if url == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(webhookPayload{
+		SavedSearch: search.Name,
+		Owner:       search.Owner,
+		Signal:      signal,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+type webhookPayload struct {
+	SavedSearch string        `json:"saved_search"`
+	Owner       string        `json:"owner"`
+	Signal      models.Signal `json:"signal"`
+}
+
+// sendEmail sends a plain-text notification via SMTP_HOST/SMTP_PORT, using
+// SMTP_USER/SMTP_PASSWORD if set. No-op if SMTP_HOST is unset or the saved
+// search's owner isn't an email address.
+func sendEmail(search models.SavedSearch, signal models.Signal) error {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		return nil
+	}
+	if _, err := mail.ParseAddress(search.Owner); err != nil {
+		return nil
+	}
+	port := os.Getenv("SMTP_PORT")
+	if port == "" {
+		port = "587"
+	}
+
+	from := os.Getenv("SMTP_FROM")
+	if from == "" {
+		from = "alerts@saa-alliance.local"
+	}
+
+	user := os.Getenv("SMTP_USER")
+	var auth smtp.Auth
+	if user != "" {
+		auth = smtp.PlainAuth("", user, os.Getenv("SMTP_PASSWORD"), host)
+	}
+
+	subject := fmt.Sprintf("Saved search %q matched: %s", search.Name, signal.Title)
+	msg := fmt.Sprintf("Subject: %s\r\n\r\n%s\r\n", subject, signal.Summary)
+
+	return smtp.SendMail(host+":"+port, auth, from, []string{search.Owner}, []byte(msg))
+}
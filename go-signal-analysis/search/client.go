@@ -0,0 +1,89 @@
+package search
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	elastic "github.com/olivere/elastic/v7"
+)
+
+// DefaultIndex is used when SEARCH_ES_INDEX is not set.
+const DefaultIndex = "signals"
+
+var (
+	client    *elastic.Client
+	indexName string
+	enabled   bool
+)
+
+// Init connects to Elasticsearch using SEARCH_ES_URL/SEARCH_ES_INDEX env vars.
+// If the connection fails or SEARCH_ES_URL is empty, search falls back to SQL
+// LIKE queries and Upsert/Reindex become no-ops.
+func Init() {
+	indexName = os.Getenv("SEARCH_ES_INDEX")
+	if indexName == "" {
+		indexName = DefaultIndex
+	}
+
+	url := os.Getenv("SEARCH_ES_URL")
+	if url == "" {
+		log.Println("SEARCH_ES_URL not set, search will fall back to SQL LIKE queries")
+		return
+	}
+
+	c, err := elastic.NewClient(
+		elastic.SetURL(url),
+		elastic.SetSniff(false),
+		elastic.SetHealthcheckTimeoutStartup(5*time.Second),
+	)
+	if err != nil {
+		log.Printf("Elasticsearch unavailable, falling back to SQL search: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := ensureIndex(ctx, c); err != nil {
+		log.Printf("Elasticsearch index setup failed, falling back to SQL search: %v", err)
+		return
+	}
+
+	client = c
+	enabled = true
+	startBulkProcessor()
+	log.Printf("Elasticsearch connected, indexing into %q", indexName)
+}
+
+// Enabled reports whether Elasticsearch is configured and reachable.
+func Enabled() bool {
+	return enabled
+}
+
+func ensureIndex(ctx context.Context, c *elastic.Client) error {
+	exists, err := c.IndexExists(indexName).Do(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	_, err = c.CreateIndex(indexName).BodyString(signalMapping).Do(ctx)
+	return err
+}
+
+const signalMapping = `{
+  "mappings": {
+    "properties": {
+      "title_clean":  { "type": "text" },
+      "title_ru":     { "type": "text" },
+      "summary":      { "type": "text" },
+      "analysis":     { "type": "text" },
+      "sector":       { "type": "keyword" },
+      "region":       { "type": "keyword" },
+      "impact":       { "type": "integer" },
+      "ts_published": { "type": "keyword" }
+    }
+  }
+}`
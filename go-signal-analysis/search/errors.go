@@ -0,0 +1,7 @@
+package search
+
+import "errors"
+
+// errNotEnabled is returned by Search when Elasticsearch is not configured
+// or unreachable, signalling callers to fall back to SQL LIKE queries.
+var errNotEnabled = errors.New("search: elasticsearch not enabled")
@@ -0,0 +1,148 @@
+package search
+
+import (
+	"context"
+	"log"
+	"time"
+
+	elastic "github.com/olivere/elastic/v7"
+	"signal-analysis/models"
+)
+
+const (
+	bulkFlushSize     = 200
+	bulkFlushInterval = 2 * time.Second
+)
+
+var processor *elastic.BulkProcessor
+
+// startBulkProcessor wires up the olivere/elastic BulkProcessor that buffers
+// Upsert() calls and flushes on size or time, retrying on 429/503 with
+// exponential backoff.
+func startBulkProcessor() {
+	p, err := client.BulkProcessor().
+		Name("signals-bulk").
+		BulkActions(bulkFlushSize).
+		FlushInterval(bulkFlushInterval).
+		Backoff(elastic.NewExponentialBackoff(100*time.Millisecond, 8*time.Second)).
+		After(afterBulk).
+		Do(context.Background())
+	if err != nil {
+		log.Printf("failed to start Elasticsearch bulk processor: %v", err)
+		enabled = false
+		return
+	}
+	processor = p
+}
+
+// afterBulk logs per-batch failures without aborting the processor.
+func afterBulk(executionId int64, requests []elastic.BulkableRequest, response *elastic.BulkResponse, err error) {
+	if err != nil {
+		log.Printf("bulk batch %d failed: %v", executionId, err)
+		return
+	}
+	if response != nil && response.Errors {
+		for _, failed := range response.Failed() {
+			log.Printf("bulk batch %d: doc %s failed: %s", executionId, failed.Id, failed.Error.Reason)
+		}
+	}
+}
+
+// Upsert queues a signal document for indexing. Safe to call even when
+// Elasticsearch is disabled (no-op).
+func Upsert(signal models.Signal) {
+	if !enabled || processor == nil {
+		return
+	}
+	req := elastic.NewBulkIndexRequest().
+		Index(indexName).
+		Id(signal.ID).
+		Doc(toDocument(signal))
+	processor.Add(req)
+}
+
+// Close flushes any buffered requests and stops the processor.
+func Close() {
+	if processor != nil {
+		_ = processor.Close()
+	}
+}
+
+type document struct {
+	TitleClean  string `json:"title_clean"`
+	TitleRu     string `json:"title_ru"`
+	Summary     string `json:"summary"`
+	Analysis    string `json:"analysis"`
+	Sector      string `json:"sector"`
+	Region      string `json:"region"`
+	Impact      int    `json:"impact"`
+	TsPublished string `json:"ts_published"`
+}
+
+func toDocument(s models.Signal) document {
+	return document{
+		TitleClean:  s.TitleClean,
+		TitleRu:     s.TitleRu,
+		Summary:     s.Summary,
+		Analysis:    s.Analysis,
+		Sector:      s.Sector,
+		Region:      s.Region,
+		Impact:      s.Impact,
+		TsPublished: s.TsPublished,
+	}
+}
+
+// Reindex streams every signal from GORM into Elasticsearch in batches,
+// reporting progress via the returned count. Intended for the reindex CLI.
+func Reindex(ctx context.Context, rows <-chan models.Signal) (int, error) {
+	if !enabled {
+		return 0, nil
+	}
+
+	bulk := client.Bulk()
+	count := 0
+	for signal := range rows {
+		bulk.Add(elastic.NewBulkIndexRequest().Index(indexName).Id(signal.ID).Doc(toDocument(signal)))
+		count++
+
+		if bulk.NumberOfActions() >= bulkFlushSize {
+			if err := flushWithRetry(ctx, bulk); err != nil {
+				return count, err
+			}
+		}
+	}
+	if bulk.NumberOfActions() > 0 {
+		if err := flushWithRetry(ctx, bulk); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// flushWithRetry sends the current bulk batch, retrying with exponential
+// backoff on 429/503 responses and logging (without aborting) per-doc failures.
+func flushWithRetry(ctx context.Context, bulk *elastic.BulkService) error {
+	backoff := elastic.NewExponentialBackoff(200*time.Millisecond, 10*time.Second)
+	for attempt := 0; ; attempt++ {
+		resp, err := bulk.Do(ctx)
+		if err == nil {
+			if resp.Errors {
+				for _, failed := range resp.Failed() {
+					log.Printf("reindex: doc %s failed: %s", failed.Id, failed.Error.Reason)
+				}
+			}
+			return nil
+		}
+
+		if elastic.IsStatusCode(err, 429) || elastic.IsStatusCode(err, 503) {
+			wait, ok := backoff.Next(attempt)
+			if !ok {
+				return err
+			}
+			log.Printf("reindex: ES busy (%v), retrying in %s", err, wait)
+			time.Sleep(wait)
+			continue
+		}
+		return err
+	}
+}
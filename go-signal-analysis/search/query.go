@@ -0,0 +1,147 @@
+package search
+
+import (
+	"context"
+
+	elastic "github.com/olivere/elastic/v7"
+)
+
+// Params describes a faceted full-text search request.
+type Params struct {
+	Query  string
+	Sector string
+	Region string
+	From   string // ts_published lower bound, inclusive
+	Size   int
+}
+
+// Hit is a single ranked result with highlighted snippets.
+type Hit struct {
+	ID         string   `json:"id"`
+	Score      float64  `json:"score"`
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+// Facets holds the aggregation buckets rendered as the dashboard's sidebar.
+type Facets struct {
+	Sectors []Bucket `json:"sectors"`
+	Regions []Bucket `json:"regions"`
+	Impact  []Bucket `json:"impact"`
+}
+
+type Bucket struct {
+	Key   string `json:"key"`
+	Count int64  `json:"count"`
+}
+
+// Result is what GET /api/search returns.
+type Result struct {
+	Hits   []Hit  `json:"hits"`
+	Facets Facets `json:"facets"`
+}
+
+// Search runs a boolean/phrase query against title_clean, title_ru, summary
+// and analysis, plus sector/region/impact aggregations. Returns an error if
+// Elasticsearch is disabled so callers can fall back to SQL.
+func Search(ctx context.Context, p Params) (*Result, error) {
+	if !enabled {
+		return nil, errNotEnabled
+	}
+
+	size := p.Size
+	if size <= 0 {
+		size = 20
+	}
+
+	query := elastic.NewBoolQuery()
+	if p.Query != "" {
+		query = query.Must(elastic.NewQueryStringQuery(p.Query).
+			Field("title_clean").
+			Field("title_ru").
+			Field("summary").
+			Field("analysis").
+			DefaultOperator("AND"))
+	}
+	if p.Sector != "" {
+		query = query.Filter(elastic.NewTermQuery("sector", p.Sector))
+	}
+	if p.Region != "" {
+		query = query.Filter(elastic.NewTermQuery("region", p.Region))
+	}
+	if p.From != "" {
+		query = query.Filter(elastic.NewRangeQuery("ts_published").Gte(p.From))
+	}
+
+	highlight := elastic.NewHighlight().Fields(
+		elastic.NewHighlighterField("title_clean"),
+		elastic.NewHighlighterField("title_ru"),
+		elastic.NewHighlighterField("summary"),
+	).PreTags("<mark>").PostTags("</mark>")
+
+	resp, err := client.Search().
+		Index(indexName).
+		Query(query).
+		Highlight(highlight).
+		Aggregation("by_sector", elastic.NewTermsAggregation().Field("sector")).
+		Aggregation("by_region", elastic.NewTermsAggregation().Field("region")).
+		Aggregation("by_impact", elastic.NewRangeAggregation().Field("impact").
+			AddRange(nil, 50).AddRange(50, 70).AddRange(70, nil)).
+		Size(size).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Hits: make([]Hit, 0, len(resp.Hits.Hits))}
+	for _, h := range resp.Hits.Hits {
+		hit := Hit{ID: h.Id, Score: scoreOf(h.Score)}
+		for _, snippets := range h.Highlight {
+			hit.Highlights = append(hit.Highlights, snippets...)
+		}
+		result.Hits = append(result.Hits, hit)
+	}
+
+	result.Facets.Sectors = termsBucket(resp, "by_sector")
+	result.Facets.Regions = termsBucket(resp, "by_region")
+	result.Facets.Impact = rangeBucket(resp, "by_impact")
+
+	return result, nil
+}
+
+func scoreOf(s *float64) float64 {
+	if s == nil {
+		return 0
+	}
+	return *s
+}
+
+func termsBucket(resp *elastic.SearchResult, name string) []Bucket {
+	agg, found := resp.Aggregations.Terms(name)
+	if !found {
+		return nil
+	}
+	buckets := make([]Bucket, 0, len(agg.Buckets))
+	for _, b := range agg.Buckets {
+		buckets = append(buckets, Bucket{Key: keyString(b.Key), Count: b.DocCount})
+	}
+	return buckets
+}
+
+func rangeBucket(resp *elastic.SearchResult, name string) []Bucket {
+	agg, found := resp.Aggregations.Range(name)
+	if !found {
+		return nil
+	}
+	buckets := make([]Bucket, 0, len(agg.Buckets))
+	for _, b := range agg.Buckets {
+		buckets = append(buckets, Bucket{Key: b.Key, Count: b.DocCount})
+	}
+	return buckets
+}
+
+func keyString(key interface{}) string {
+	if s, ok := key.(string); ok {
+		return s
+	}
+	return ""
+}
@@ -0,0 +1,69 @@
+package sse
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ServeHTTP handles GET /api/stream/signals: it upgrades the connection to
+// an SSE stream, replays events after Last-Event-ID (if present), then
+// forwards broadcasts until the client disconnects.
+func (h *Hub) ServeHTTP(c *gin.Context) {
+	filter := Filter{
+		Sector:    c.Query("sector"),
+		MinImpact: queryInt(c, "min_impact"),
+	}
+	lastEventID := queryInt64(c.GetHeader("Last-Event-ID"))
+
+	client, replay := h.subscribe(filter, lastEventID)
+	defer h.unsubscribe(client)
+
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.WriteHeader(200)
+	c.Writer.Flush()
+
+	for _, e := range replay {
+		writeEvent(c, e)
+	}
+
+	heartbeat := time.NewTicker(HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-client.ch:
+			writeEvent(c, e)
+		case <-heartbeat.C:
+			fmt.Fprint(c.Writer, ": heartbeat\n\n")
+			c.Writer.Flush()
+		}
+	}
+}
+
+func writeEvent(c *gin.Context, e Event) {
+	payload, err := json.Marshal(e.Signal)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Name, payload)
+	c.Writer.Flush()
+}
+
+func queryInt(c *gin.Context, key string) int {
+	v, _ := strconv.Atoi(c.Query(key))
+	return v
+}
+
+func queryInt64(raw string) int64 {
+	v, _ := strconv.ParseInt(raw, 10, 64)
+	return v
+}
@@ -0,0 +1,145 @@
+// Package sse implements a small Server-Sent Events hub that lets the
+// dashboard receive newly ingested signals and completed analyses without
+// polling.
+package sse
+
+import (
+	"sync"
+	"time"
+
+	"signal-analysis/models"
+)
+
+const (
+	// clientBufferSize is the per-client bounded channel size; once full the
+	// oldest queued event is dropped so a slow client can't block the hub.
+	clientBufferSize = 32
+	// ringBufferSize bounds how many past events can be replayed via
+	// Last-Event-ID.
+	ringBufferSize = 200
+	// HeartbeatInterval is how often a keep-alive comment is sent to defeat
+	// proxy idle timeouts.
+	HeartbeatInterval = 15 * time.Second
+)
+
+// Event is a single SSE frame.
+type Event struct {
+	ID     int64
+	Name   string // "analysis", "signal", ...
+	Signal models.Signal
+}
+
+// Filter narrows which events a client receives.
+type Filter struct {
+	Sector    string
+	MinImpact int
+}
+
+func (f Filter) matches(e Event) bool {
+	if f.Sector != "" && e.Signal.Sector != f.Sector {
+		return false
+	}
+	if f.MinImpact > 0 && e.Signal.Impact < f.MinImpact {
+		return false
+	}
+	return true
+}
+
+type client struct {
+	ch     chan Event
+	filter Filter
+}
+
+// Hub fans out broadcast events to connected clients and keeps a ring buffer
+// of recent events for Last-Event-ID replay.
+type Hub struct {
+	mu      sync.Mutex
+	clients map[*client]struct{}
+	ring    []Event
+	nextID  int64
+}
+
+// NewHub creates an empty, ready-to-use Hub.
+func NewHub() *Hub {
+	return &Hub{clients: make(map[*client]struct{})}
+}
+
+// defaultHub is the process-wide hub wired up in main and used by handlers
+// that broadcast signal/analysis updates.
+var defaultHub = NewHub()
+
+// Default returns the process-wide hub.
+func Default() *Hub {
+	return defaultHub
+}
+
+// Broadcast pushes signal to every connected client whose filter matches,
+// assigning it the next monotonic event ID and recording it in the replay
+// ring buffer.
+func (h *Hub) Broadcast(signal models.Signal, event string) {
+	h.mu.Lock()
+	h.nextID++
+	e := Event{ID: h.nextID, Name: event, Signal: signal}
+	h.ring = append(h.ring, e)
+	if len(h.ring) > ringBufferSize {
+		h.ring = h.ring[len(h.ring)-ringBufferSize:]
+	}
+	clients := make([]*client, 0, len(h.clients))
+	for c := range h.clients {
+		clients = append(clients, c)
+	}
+	h.mu.Unlock()
+
+	for _, c := range clients {
+		if !c.filter.matches(e) {
+			continue
+		}
+		select {
+		case c.ch <- e:
+		default:
+			// буфер клиента переполнен - роняем старейшее событие и пишем новое
+			select {
+			case <-c.ch:
+			default:
+			}
+			select {
+			case c.ch <- e:
+			default:
+			}
+		}
+	}
+}
+
+// subscribe registers a new client and returns it along with any replay
+// events newer than lastEventID (0 means no replay requested).
+func (h *Hub) subscribe(filter Filter, lastEventID int64) (*client, []Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	c := &client{ch: make(chan Event, clientBufferSize), filter: filter}
+	h.clients[c] = struct{}{}
+
+	var replay []Event
+	if lastEventID > 0 {
+		for _, e := range h.ring {
+			if e.ID > lastEventID && filter.matches(e) {
+				replay = append(replay, e)
+			}
+		}
+	}
+	return c, replay
+}
+
+func (h *Hub) unsubscribe(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.clients, c)
+	// Don't close c.ch here: Broadcast reads h.clients and sends to c.ch
+	// under separate lock acquisitions, so a concurrent Broadcast can still
+	// be holding a reference to c and about to send after this client has
+	// unsubscribed. Closing would race a send on a closed channel, which
+	// panics rather than hitting Broadcast's select/default. The client
+	// loop already exits via ctx.Done() when the connection goes away, so
+	// the channel is simply left for the garbage collector once c drops
+	// out of h.clients.
+}
@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"signal-analysis/analysis"
+	"signal-analysis/database"
+	"signal-analysis/notify"
+	"signal-analysis/search"
+	"signal-analysis/sse"
+)
+
+// autoAnalyzeBatchSize caps how many candidate signals a single tick
+// considers, so one slow LLM provider can't make the job run forever.
+const autoAnalyzeBatchSize = 200
+
+// AutoAnalyze takes the autoAnalyzeBatchSize most recent signals at or above
+// threshold impact and generates an analysis for each one that doesn't have
+// one yet; older candidates are not considered. It mirrors the same post-save side
+// effects (search index, SSE broadcast, saved-search notifications) as the
+// handler-driven GenerateAnalysis path.
+func AutoAnalyze(store database.Store, provider analysis.Provider, threshold int) error {
+	signals, err := store.ListSignals(database.FilterParams{MinImpact: threshold}, autoAnalyzeBatchSize)
+	if err != nil {
+		return fmt.Errorf("list signals: %w", err)
+	}
+
+	var generated int
+	for _, signal := range signals {
+		if signal.Analysis != "" {
+			continue
+		}
+
+		result, err := provider.GenerateAnalysis(context.Background(), signal, "en", "")
+		if err != nil {
+			fmt.Printf("worker: auto-analyze: signal %s failed: %v\n", signal.ID, err)
+			continue
+		}
+		signal.Analysis = result
+
+		if err := store.SaveAnalysis(&signal); err != nil {
+			fmt.Printf("worker: auto-analyze: failed to save signal %s: %v\n", signal.ID, err)
+			continue
+		}
+
+		search.Upsert(signal)
+		sse.Default().Broadcast(signal, "analysis")
+		_ = notify.EvaluateAndNotify(store, signal)
+		generated++
+	}
+
+	fmt.Printf("worker: auto-analyze: generated %d analyses\n", generated)
+	return nil
+}
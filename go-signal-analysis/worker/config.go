@@ -0,0 +1,81 @@
+package worker
+
+import (
+	"os"
+	"strconv"
+)
+
+// Config controls which background jobs run and on what schedule. Every
+// field has an env-driven default so operators can retune or disable a job
+// without a rebuild.
+type Config struct {
+	SourcesFile string
+
+	IngestEnabled bool
+	IngestCron    string
+
+	AutoAnalyzeEnabled   bool
+	AutoAnalyzeCron      string
+	AutoAnalyzeThreshold int
+
+	TrustScoreEnabled bool
+	TrustScoreCron    string
+
+	PurgeEnabled   bool
+	PurgeCron      string
+	PurgeAfterDays int
+}
+
+// ConfigFromEnv builds a Config from WORKER_* environment variables. Every
+// job defaults to enabled; set the matching *_ENABLED var to "false" to turn
+// one off.
+func ConfigFromEnv() Config {
+	return Config{
+		SourcesFile: envOrDefault("WORKER_SOURCES_FILE", "sources.yaml"),
+
+		IngestEnabled: envBool("WORKER_INGEST_ENABLED", true),
+		IngestCron:    envOrDefault("WORKER_INGEST_CRON", "@every 5m"),
+
+		AutoAnalyzeEnabled:   envBool("WORKER_AUTOANALYZE_ENABLED", true),
+		AutoAnalyzeCron:      envOrDefault("WORKER_AUTOANALYZE_CRON", "@every 10m"),
+		AutoAnalyzeThreshold: envInt("WORKER_AUTOANALYZE_THRESHOLD", 70),
+
+		TrustScoreEnabled: envBool("WORKER_TRUSTSCORE_ENABLED", true),
+		TrustScoreCron:    envOrDefault("WORKER_TRUSTSCORE_CRON", "0 2 * * *"),
+
+		PurgeEnabled:   envBool("WORKER_PURGE_ENABLED", true),
+		PurgeCron:      envOrDefault("WORKER_PURGE_CRON", "0 3 * * *"),
+		PurgeAfterDays: envInt("WORKER_PURGE_AFTER_DAYS", 14),
+	}
+}
+
+func envOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func envBool(key string, fallback bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return fallback
+	}
+	return b
+}
+
+func envInt(key string, fallback int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return fallback
+	}
+	return n
+}
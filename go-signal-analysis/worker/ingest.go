@@ -0,0 +1,164 @@
+package worker
+
+import (
+	"crypto/sha256"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"signal-analysis/database"
+	"signal-analysis/models"
+	"signal-analysis/notify"
+	"signal-analysis/sse"
+)
+
+var httpClient = &http.Client{Timeout: 15 * time.Second}
+
+// sourcesFile is the on-disk shape of sources.yaml: one RSS/Atom feed per
+// entry, tagged with the domain recorded on every Signal it produces.
+type sourcesFile struct {
+	Sources []struct {
+		Domain string `yaml:"domain"`
+		URL    string `yaml:"url"`
+	} `yaml:"sources"`
+}
+
+// rssFeed and atomFeed cover only the fields IngestFeeds needs - title,
+// link, published date - not a general-purpose feed parser.
+type rssFeed struct {
+	Channel struct {
+		Items []struct {
+			Title   string `xml:"title"`
+			Link    string `xml:"link"`
+			PubDate string `xml:"pubDate"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+type atomFeed struct {
+	Entries []struct {
+		Title   string `xml:"title"`
+		Updated string `xml:"updated"`
+		Link    struct {
+			Href string `xml:"href,attr"`
+		} `xml:"link"`
+	} `xml:"entry"`
+}
+
+type feedItem struct {
+	link      string
+	title     string
+	published string
+}
+
+// IngestFeeds polls every feed listed in the sources file at path and
+// inserts any item whose URL-hash ID isn't already a known Signal. A single
+// feed failing to fetch or parse is logged and skipped rather than
+// aborting the rest.
+func IngestFeeds(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read sources file: %w", err)
+	}
+
+	var cfg sourcesFile
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("parse sources file: %w", err)
+	}
+
+	db := database.GetDB()
+	if db == nil {
+		return fmt.Errorf("no direct database connection available")
+	}
+
+	var inserted int
+	for _, src := range cfg.Sources {
+		items, err := fetchFeed(src.URL)
+		if err != nil {
+			fmt.Printf("worker: ingest: failed to fetch %s: %v\n", src.URL, err)
+			continue
+		}
+
+		for _, item := range items {
+			if item.link == "" {
+				continue
+			}
+			signal := models.Signal{
+				ID:           urlHash(item.link),
+				TsPublished:  item.published,
+				TsIngested:   time.Now().UTC().Format(time.RFC3339),
+				SourceDomain: src.Domain,
+				URL:          item.link,
+				Title:        item.title,
+			}
+			// Dedup on URL hash: ID is deterministic from the link, so a
+			// repeat fetch of the same item just no-ops here.
+			result := db.Where("id = ?", signal.ID).FirstOrCreate(&signal)
+			if result.Error != nil {
+				fmt.Printf("worker: ingest: failed to insert %s: %v\n", item.link, result.Error)
+				continue
+			}
+			if result.RowsAffected > 0 {
+				inserted++
+				sse.Default().Broadcast(signal, "signal")
+				// Evaluate against notify-enabled saved searches as soon as
+				// the signal exists, same as the analysis paths do once a
+				// signal is scored - a saved search whose filter uses only
+				// what ingestion already fills in (the published date) can
+				// match even before analysis runs.
+				if err := notify.EvaluateAndNotify(database.GetStore(), signal); err != nil {
+					fmt.Printf("worker: ingest: notify evaluation failed for %s: %v\n", signal.ID, err)
+				}
+			}
+		}
+	}
+
+	fmt.Printf("worker: ingest: inserted %d new signals\n", inserted)
+	return nil
+}
+
+// fetchFeed downloads url and parses it as RSS 2.0, falling back to Atom if
+// no RSS items were found.
+func fetchFeed(url string) ([]feedItem, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var rss rssFeed
+	if err := xml.Unmarshal(body, &rss); err == nil && len(rss.Channel.Items) > 0 {
+		items := make([]feedItem, 0, len(rss.Channel.Items))
+		for _, it := range rss.Channel.Items {
+			items = append(items, feedItem{link: it.Link, title: it.Title, published: it.PubDate})
+		}
+		return items, nil
+	}
+
+	var atom atomFeed
+	if err := xml.Unmarshal(body, &atom); err != nil {
+		return nil, fmt.Errorf("unrecognized feed format: %w", err)
+	}
+	items := make([]feedItem, 0, len(atom.Entries))
+	for _, e := range atom.Entries {
+		items = append(items, feedItem{link: e.Link.Href, title: e.Title, published: e.Updated})
+	}
+	return items, nil
+}
+
+// urlHash derives a stable Signal.ID from a feed item's link so re-fetching
+// the same item is a no-op instead of a duplicate row.
+func urlHash(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return fmt.Sprintf("rss-%x", sum[:8])
+}
@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"signal-analysis/database"
+	"signal-analysis/models"
+)
+
+// PurgeTestSignals deletes is_test=true signals ingested more than
+// olderThanDays ago, so fixture/load-test rows left behind by earlier
+// runs don't accumulate in the dataset.
+func PurgeTestSignals(olderThanDays int) error {
+	db := database.GetDB()
+	if db == nil {
+		return fmt.Errorf("no direct database connection available")
+	}
+
+	cutoff := time.Now().UTC().AddDate(0, 0, -olderThanDays).Format(time.RFC3339)
+	result := db.Where("is_test = ? AND ts_ingested < ?", true, cutoff).Delete(&models.Signal{})
+	if result.Error != nil {
+		return fmt.Errorf("purge test signals: %w", result.Error)
+	}
+
+	fmt.Printf("worker: purge: deleted %d test signals older than %d days\n", result.RowsAffected, olderThanDays)
+	return nil
+}
@@ -0,0 +1,166 @@
+// Package worker runs the scheduled maintenance jobs that keep signals
+// flowing and the dataset healthy without an operator: feed ingestion,
+// auto-analysis of high-impact signals, trust score recompute, and stale
+// test-data purge.
+package worker
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// JobStatus reports the current state of one scheduled job, exposed via
+// GET /api/jobs so operators can see what's running without digging
+// through logs.
+type JobStatus struct {
+	Name          string    `json:"name"`
+	Enabled       bool      `json:"enabled"`
+	Schedule      string    `json:"schedule"`
+	Running       bool      `json:"running"`
+	LastStarted   time.Time `json:"last_started,omitempty"`
+	LastCompleted time.Time `json:"last_completed,omitempty"`
+	LastDuration  string    `json:"last_duration,omitempty"`
+	LastError     string    `json:"last_error,omitempty"`
+	NextRun       time.Time `json:"next_run,omitempty"`
+}
+
+// Scheduler runs a fixed set of maintenance jobs on their own cron
+// schedules. Each job is guarded so an overrunning tick is skipped rather
+// than stacked on top of the one still in flight.
+type Scheduler struct {
+	cron *cron.Cron
+
+	mu      sync.Mutex
+	jobs    map[string]*job
+	entries map[string]cron.EntryID
+}
+
+// job tracks one registered job's schedule and last-run bookkeeping.
+// runMu is the overlap guard: TryLock fails (and the tick is skipped) if the
+// previous run hasn't finished yet. statusMu protects the fields read by
+// Statuses, which run on a different goroutine than the job itself.
+type job struct {
+	name     string
+	schedule string
+	enabled  bool
+	fn       func() error
+
+	runMu sync.Mutex
+
+	statusMu      sync.Mutex
+	running       bool
+	lastStarted   time.Time
+	lastCompleted time.Time
+	lastDuration  time.Duration
+	lastErr       error
+}
+
+// NewScheduler returns a Scheduler with no jobs registered yet.
+func NewScheduler() *Scheduler {
+	return &Scheduler{
+		cron:    cron.New(),
+		jobs:    map[string]*job{},
+		entries: map[string]cron.EntryID{},
+	}
+}
+
+// Register adds a named job on the given cron schedule. If enabled is
+// false, the job is tracked (and reported by Statuses) but never scheduled.
+func (s *Scheduler) Register(name, schedule string, enabled bool, fn func() error) {
+	j := &job{name: name, schedule: schedule, enabled: enabled, fn: fn}
+
+	s.mu.Lock()
+	s.jobs[name] = j
+	s.mu.Unlock()
+
+	if !enabled {
+		log.Printf("worker: job %q disabled, not scheduling", name)
+		return
+	}
+
+	id, err := s.cron.AddFunc(schedule, func() { s.runTick(j) })
+	if err != nil {
+		log.Printf("worker: failed to schedule job %q (%q): %v", name, schedule, err)
+		return
+	}
+
+	s.mu.Lock()
+	s.entries[name] = id
+	s.mu.Unlock()
+}
+
+func (s *Scheduler) runTick(j *job) {
+	if !j.runMu.TryLock() {
+		log.Printf("worker: job %q still running, skipping this tick", j.name)
+		return
+	}
+	defer j.runMu.Unlock()
+
+	start := time.Now()
+	j.statusMu.Lock()
+	j.running = true
+	j.lastStarted = start
+	j.statusMu.Unlock()
+
+	err := j.fn()
+	duration := time.Since(start)
+
+	j.statusMu.Lock()
+	j.running = false
+	j.lastCompleted = time.Now()
+	j.lastDuration = duration
+	j.lastErr = err
+	j.statusMu.Unlock()
+
+	if err != nil {
+		log.Printf("worker: job %q failed after %s: %v", j.name, duration, err)
+		return
+	}
+	log.Printf("worker: job %q completed in %s", j.name, duration)
+}
+
+// Start begins running every enabled job in the background.
+func (s *Scheduler) Start() {
+	s.cron.Start()
+}
+
+// Stop cancels future ticks and waits for any in-flight job to finish.
+func (s *Scheduler) Stop() {
+	<-s.cron.Stop().Done()
+}
+
+// Statuses reports the current state of every registered job, scheduled or
+// not. Order is map iteration order, not registration order.
+func (s *Scheduler) Statuses() []JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for name, j := range s.jobs {
+		j.statusMu.Lock()
+		st := JobStatus{
+			Name:          name,
+			Enabled:       j.enabled,
+			Schedule:      j.schedule,
+			Running:       j.running,
+			LastStarted:   j.lastStarted,
+			LastCompleted: j.lastCompleted,
+		}
+		if j.lastDuration > 0 {
+			st.LastDuration = j.lastDuration.String()
+		}
+		if j.lastErr != nil {
+			st.LastError = j.lastErr.Error()
+		}
+		j.statusMu.Unlock()
+
+		if id, ok := s.entries[name]; ok {
+			st.NextRun = s.cron.Entry(id).Next
+		}
+		statuses = append(statuses, st)
+	}
+	return statuses
+}
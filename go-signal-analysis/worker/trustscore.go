@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"fmt"
+
+	"signal-analysis/database"
+	"signal-analysis/models"
+)
+
+// domainHistory aggregates one SourceDomain's historical Confidence/Impact.
+type domainHistory struct {
+	SourceDomain  string
+	AvgConfidence float64
+	AvgImpact     float64
+}
+
+// RecomputeTrustScores derives each SourceDomain's TrustScore from the mean
+// Confidence/Impact of its non-test signals, normalized to 0-1, and writes
+// it back onto every signal from that domain.
+func RecomputeTrustScores() error {
+	db := database.GetDB()
+	if db == nil {
+		return fmt.Errorf("no direct database connection available")
+	}
+
+	var rows []domainHistory
+	err := db.Table("signals").
+		Select("source_domain, AVG(confidence) AS avg_confidence, AVG(impact) AS avg_impact").
+		Where("is_test = ?", false).
+		Group("source_domain").
+		Scan(&rows).Error
+	if err != nil {
+		return fmt.Errorf("aggregate domain history: %w", err)
+	}
+
+	for _, row := range rows {
+		trustScore := (row.AvgConfidence/100 + row.AvgImpact/100) / 2
+		err := db.Model(&models.Signal{}).
+			Where("source_domain = ?", row.SourceDomain).
+			Update("trust_score", trustScore).Error
+		if err != nil {
+			fmt.Printf("worker: trust-score: failed to update %s: %v\n", row.SourceDomain, err)
+		}
+	}
+
+	fmt.Printf("worker: trust-score: recomputed for %d domains\n", len(rows))
+	return nil
+}
@@ -0,0 +1,30 @@
+package worker
+
+import (
+	"signal-analysis/analysis"
+	"signal-analysis/database"
+)
+
+// Start builds a Scheduler wired up with the ingest, auto-analyze,
+// trust-score and purge jobs, using cfg to decide which ones run and on
+// what schedule, then starts it. Callers should defer Stop() on the
+// returned Scheduler and pass it to handlers.SetScheduler for GET /api/jobs.
+func Start(cfg Config, store database.Store, provider analysis.Provider) *Scheduler {
+	s := NewScheduler()
+
+	s.Register("ingest", cfg.IngestCron, cfg.IngestEnabled, func() error {
+		return IngestFeeds(cfg.SourcesFile)
+	})
+	s.Register("auto-analyze", cfg.AutoAnalyzeCron, cfg.AutoAnalyzeEnabled, func() error {
+		return AutoAnalyze(store, provider, cfg.AutoAnalyzeThreshold)
+	})
+	s.Register("trust-score", cfg.TrustScoreCron, cfg.TrustScoreEnabled, func() error {
+		return RecomputeTrustScores()
+	})
+	s.Register("purge", cfg.PurgeCron, cfg.PurgeEnabled, func() error {
+		return PurgeTestSignals(cfg.PurgeAfterDays)
+	})
+
+	s.Start()
+	return s
+}